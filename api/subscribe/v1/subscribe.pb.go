@@ -82,8 +82,9 @@ type SubscribeEntitiesByIDsResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Id      uint64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status  string          `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Results []*EntityResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
 }
 
 func (x *SubscribeEntitiesByIDsResponse) Reset() {
@@ -132,6 +133,13 @@ func (x *SubscribeEntitiesByIDsResponse) GetStatus() string {
 	return ""
 }
 
+func (x *SubscribeEntitiesByIDsResponse) GetResults() []*EntityResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
 type SubscribeEntitiesByGroupsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -192,8 +200,9 @@ type SubscribeEntitiesByGroupsResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Id      uint64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status  string          `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Results []*EntityResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
 }
 
 func (x *SubscribeEntitiesByGroupsResponse) Reset() {
@@ -242,6 +251,13 @@ func (x *SubscribeEntitiesByGroupsResponse) GetStatus() string {
 	return ""
 }
 
+func (x *SubscribeEntitiesByGroupsResponse) GetResults() []*EntityResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
 type SubscribeEntitiesByModelsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -302,8 +318,9 @@ type SubscribeEntitiesByModelsResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Id      uint64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status  string          `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Results []*EntityResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
 }
 
 func (x *SubscribeEntitiesByModelsResponse) Reset() {
@@ -352,6 +369,13 @@ func (x *SubscribeEntitiesByModelsResponse) GetStatus() string {
 	return ""
 }
 
+func (x *SubscribeEntitiesByModelsResponse) GetResults() []*EntityResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
 type UnsubscribeEntitiesByIDsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -462,16 +486,18 @@ func (x *UnsubscribeEntitiesByIDsResponse) GetStatus() string {
 	return ""
 }
 
-type DeleteEntitiesByIDRequest struct {
+type UnsubscribeEntitiesByGroupsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id               uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Groups           []string `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	IncludeSubgroups bool     `protobuf:"varint,3,opt,name=include_subgroups,json=includeSubgroups,proto3" json:"include_subgroups,omitempty"`
 }
 
-func (x *DeleteEntitiesByIDRequest) Reset() {
-	*x = DeleteEntitiesByIDRequest{}
+func (x *UnsubscribeEntitiesByGroupsRequest) Reset() {
+	*x = UnsubscribeEntitiesByGroupsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -479,13 +505,13 @@ func (x *DeleteEntitiesByIDRequest) Reset() {
 	}
 }
 
-func (x *DeleteEntitiesByIDRequest) String() string {
+func (x *UnsubscribeEntitiesByGroupsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEntitiesByIDRequest) ProtoMessage() {}
+func (*UnsubscribeEntitiesByGroupsRequest) ProtoMessage() {}
 
-func (x *DeleteEntitiesByIDRequest) ProtoReflect() protoreflect.Message {
+func (x *UnsubscribeEntitiesByGroupsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -497,28 +523,44 @@ func (x *DeleteEntitiesByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEntitiesByIDRequest.ProtoReflect.Descriptor instead.
-func (*DeleteEntitiesByIDRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnsubscribeEntitiesByGroupsRequest.ProtoReflect.Descriptor instead.
+func (*UnsubscribeEntitiesByGroupsRequest) Descriptor() ([]byte, []int) {
 	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *DeleteEntitiesByIDRequest) GetId() string {
+func (x *UnsubscribeEntitiesByGroupsRequest) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type DeleteEntitiesByIDResponse struct {
+func (x *UnsubscribeEntitiesByGroupsRequest) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *UnsubscribeEntitiesByGroupsRequest) GetIncludeSubgroups() bool {
+	if x != nil {
+		return x.IncludeSubgroups
+	}
+	return false
+}
+
+type UnsubscribeEntitiesByGroupsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id      uint64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status  string          `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Results []*EntityResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func (x *DeleteEntitiesByIDResponse) Reset() {
-	*x = DeleteEntitiesByIDResponse{}
+func (x *UnsubscribeEntitiesByGroupsResponse) Reset() {
+	*x = UnsubscribeEntitiesByGroupsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -526,13 +568,13 @@ func (x *DeleteEntitiesByIDResponse) Reset() {
 	}
 }
 
-func (x *DeleteEntitiesByIDResponse) String() string {
+func (x *UnsubscribeEntitiesByGroupsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEntitiesByIDResponse) ProtoMessage() {}
+func (*UnsubscribeEntitiesByGroupsResponse) ProtoMessage() {}
 
-func (x *DeleteEntitiesByIDResponse) ProtoReflect() protoreflect.Message {
+func (x *UnsubscribeEntitiesByGroupsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -544,34 +586,43 @@ func (x *DeleteEntitiesByIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEntitiesByIDResponse.ProtoReflect.Descriptor instead.
-func (*DeleteEntitiesByIDResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnsubscribeEntitiesByGroupsResponse.ProtoReflect.Descriptor instead.
+func (*UnsubscribeEntitiesByGroupsResponse) Descriptor() ([]byte, []int) {
 	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DeleteEntitiesByIDResponse) GetId() string {
+func (x *UnsubscribeEntitiesByGroupsResponse) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
+	return 0
+}
+
+func (x *UnsubscribeEntitiesByGroupsResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
 	return ""
 }
 
-type ListSubscribeEntitiesRequest struct {
+func (x *UnsubscribeEntitiesByGroupsResponse) GetResults() []*EntityResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type UnsubscribeEntitiesByModelsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PageNum      uint64 `protobuf:"varint,1,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
-	PageSize     uint64 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	OrderBy      string `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	IsDescending bool   `protobuf:"varint,4,opt,name=is_descending,json=isDescending,proto3" json:"is_descending,omitempty"`
-	KeyWords     string `protobuf:"bytes,5,opt,name=key_words,json=keyWords,proto3" json:"key_words,omitempty"`
-	SearchKey    string `protobuf:"bytes,6,opt,name=search_key,json=searchKey,proto3" json:"search_key,omitempty"`
-	Id           uint64 `protobuf:"varint,7,opt,name=id,proto3" json:"id,omitempty"`
+	Id     uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Models []string `protobuf:"bytes,2,rep,name=models,proto3" json:"models,omitempty"`
 }
 
-func (x *ListSubscribeEntitiesRequest) Reset() {
-	*x = ListSubscribeEntitiesRequest{}
+func (x *UnsubscribeEntitiesByModelsRequest) Reset() {
+	*x = UnsubscribeEntitiesByModelsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -579,13 +630,13 @@ func (x *ListSubscribeEntitiesRequest) Reset() {
 	}
 }
 
-func (x *ListSubscribeEntitiesRequest) String() string {
+func (x *UnsubscribeEntitiesByModelsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSubscribeEntitiesRequest) ProtoMessage() {}
+func (*UnsubscribeEntitiesByModelsRequest) ProtoMessage() {}
 
-func (x *ListSubscribeEntitiesRequest) ProtoReflect() protoreflect.Message {
+func (x *UnsubscribeEntitiesByModelsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -597,74 +648,37 @@ func (x *ListSubscribeEntitiesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSubscribeEntitiesRequest.ProtoReflect.Descriptor instead.
-func (*ListSubscribeEntitiesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnsubscribeEntitiesByModelsRequest.ProtoReflect.Descriptor instead.
+func (*UnsubscribeEntitiesByModelsRequest) Descriptor() ([]byte, []int) {
 	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ListSubscribeEntitiesRequest) GetPageNum() uint64 {
-	if x != nil {
-		return x.PageNum
-	}
-	return 0
-}
-
-func (x *ListSubscribeEntitiesRequest) GetPageSize() uint64 {
+func (x *UnsubscribeEntitiesByModelsRequest) GetId() uint64 {
 	if x != nil {
-		return x.PageSize
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ListSubscribeEntitiesRequest) GetOrderBy() string {
-	if x != nil {
-		return x.OrderBy
-	}
-	return ""
-}
-
-func (x *ListSubscribeEntitiesRequest) GetIsDescending() bool {
-	if x != nil {
-		return x.IsDescending
-	}
-	return false
-}
-
-func (x *ListSubscribeEntitiesRequest) GetKeyWords() string {
-	if x != nil {
-		return x.KeyWords
-	}
-	return ""
-}
-
-func (x *ListSubscribeEntitiesRequest) GetSearchKey() string {
-	if x != nil {
-		return x.SearchKey
-	}
-	return ""
-}
-
-func (x *ListSubscribeEntitiesRequest) GetId() uint64 {
+func (x *UnsubscribeEntitiesByModelsRequest) GetModels() []string {
 	if x != nil {
-		return x.Id
+		return x.Models
 	}
-	return 0
+	return nil
 }
 
-type ListSubscribeEntitiesResponse struct {
+type UnsubscribeEntitiesByModelsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total    uint64    `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	PageNum  uint64    `protobuf:"varint,2,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
-	LastPage uint64    `protobuf:"varint,3,opt,name=last_page,json=lastPage,proto3" json:"last_page,omitempty"`
-	PageSize uint64    `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Data     []*Entity `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty"`
+	Id      uint64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status  string          `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Results []*EntityResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func (x *ListSubscribeEntitiesResponse) Reset() {
-	*x = ListSubscribeEntitiesResponse{}
+func (x *UnsubscribeEntitiesByModelsResponse) Reset() {
+	*x = UnsubscribeEntitiesByModelsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -672,13 +686,13 @@ func (x *ListSubscribeEntitiesResponse) Reset() {
 	}
 }
 
-func (x *ListSubscribeEntitiesResponse) String() string {
+func (x *UnsubscribeEntitiesByModelsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSubscribeEntitiesResponse) ProtoMessage() {}
+func (*UnsubscribeEntitiesByModelsResponse) ProtoMessage() {}
 
-func (x *ListSubscribeEntitiesResponse) ProtoReflect() protoreflect.Message {
+func (x *UnsubscribeEntitiesByModelsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -690,60 +704,44 @@ func (x *ListSubscribeEntitiesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSubscribeEntitiesResponse.ProtoReflect.Descriptor instead.
-func (*ListSubscribeEntitiesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnsubscribeEntitiesByModelsResponse.ProtoReflect.Descriptor instead.
+func (*UnsubscribeEntitiesByModelsResponse) Descriptor() ([]byte, []int) {
 	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ListSubscribeEntitiesResponse) GetTotal() uint64 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *ListSubscribeEntitiesResponse) GetPageNum() uint64 {
-	if x != nil {
-		return x.PageNum
-	}
-	return 0
-}
-
-func (x *ListSubscribeEntitiesResponse) GetLastPage() uint64 {
+func (x *UnsubscribeEntitiesByModelsResponse) GetId() uint64 {
 	if x != nil {
-		return x.LastPage
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ListSubscribeEntitiesResponse) GetPageSize() uint64 {
+func (x *UnsubscribeEntitiesByModelsResponse) GetStatus() string {
 	if x != nil {
-		return x.PageSize
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-func (x *ListSubscribeEntitiesResponse) GetData() []*Entity {
+func (x *UnsubscribeEntitiesByModelsResponse) GetResults() []*EntityResult {
 	if x != nil {
-		return x.Data
+		return x.Results
 	}
 	return nil
 }
 
-type SubscribeObject struct {
+type EntityResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Endpoint    string `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	IsDefault   bool   `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status  string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *SubscribeObject) Reset() {
-	*x = SubscribeObject{}
+func (x *EntityResult) Reset() {
+	*x = EntityResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -751,13 +749,13 @@ func (x *SubscribeObject) Reset() {
 	}
 }
 
-func (x *SubscribeObject) String() string {
+func (x *EntityResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeObject) ProtoMessage() {}
+func (*EntityResult) ProtoMessage() {}
 
-func (x *SubscribeObject) ProtoReflect() protoreflect.Message {
+func (x *EntityResult) ProtoReflect() protoreflect.Message {
 	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -769,57 +767,42 @@ func (x *SubscribeObject) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeObject.ProtoReflect.Descriptor instead.
-func (*SubscribeObject) Descriptor() ([]byte, []int) {
+// Deprecated: Use EntityResult.ProtoReflect.Descriptor instead.
+func (*EntityResult) Descriptor() ([]byte, []int) {
 	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *SubscribeObject) GetId() uint64 {
+func (x *EntityResult) GetId() string {
 	if x != nil {
 		return x.Id
 	}
-	return 0
-}
-
-func (x *SubscribeObject) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
 	return ""
 }
 
-func (x *SubscribeObject) GetDescription() string {
+func (x *EntityResult) GetStatus() string {
 	if x != nil {
-		return x.Description
+		return x.Status
 	}
 	return ""
 }
 
-func (x *SubscribeObject) GetEndpoint() string {
+func (x *EntityResult) GetMessage() string {
 	if x != nil {
-		return x.Endpoint
+		return x.Message
 	}
 	return ""
 }
 
-func (x *SubscribeObject) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
-	}
-	return false
-}
-
-type CreateSubscribeRequest struct {
+type DeleteEntitiesByIDRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *CreateSubscribeRequest) Reset() {
-	*x = CreateSubscribeRequest{}
+func (x *DeleteEntitiesByIDRequest) Reset() {
+	*x = DeleteEntitiesByIDRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -827,13 +810,13 @@ func (x *CreateSubscribeRequest) Reset() {
 	}
 }
 
-func (x *CreateSubscribeRequest) String() string {
+func (x *DeleteEntitiesByIDRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSubscribeRequest) ProtoMessage() {}
+func (*DeleteEntitiesByIDRequest) ProtoMessage() {}
 
-func (x *CreateSubscribeRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteEntitiesByIDRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -845,39 +828,28 @@ func (x *CreateSubscribeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSubscribeRequest.ProtoReflect.Descriptor instead.
-func (*CreateSubscribeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteEntitiesByIDRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEntitiesByIDRequest) Descriptor() ([]byte, []int) {
 	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CreateSubscribeRequest) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *CreateSubscribeRequest) GetDescription() string {
+func (x *DeleteEntitiesByIDRequest) GetId() string {
 	if x != nil {
-		return x.Description
+		return x.Id
 	}
 	return ""
 }
 
-type CreateSubscribeResponse struct {
+type DeleteEntitiesByIDResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Endpoint    string `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	IsDefault   bool   `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *CreateSubscribeResponse) Reset() {
-	*x = CreateSubscribeResponse{}
+func (x *DeleteEntitiesByIDResponse) Reset() {
+	*x = DeleteEntitiesByIDResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -885,13 +857,13 @@ func (x *CreateSubscribeResponse) Reset() {
 	}
 }
 
-func (x *CreateSubscribeResponse) String() string {
+func (x *DeleteEntitiesByIDResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSubscribeResponse) ProtoMessage() {}
+func (*DeleteEntitiesByIDResponse) ProtoMessage() {}
 
-func (x *CreateSubscribeResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteEntitiesByIDResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -903,58 +875,40 @@ func (x *CreateSubscribeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSubscribeResponse.ProtoReflect.Descriptor instead.
-func (*CreateSubscribeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteEntitiesByIDResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEntitiesByIDResponse) Descriptor() ([]byte, []int) {
 	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *CreateSubscribeResponse) GetId() uint64 {
+func (x *DeleteEntitiesByIDResponse) GetId() string {
 	if x != nil {
 		return x.Id
 	}
-	return 0
-}
-
-func (x *CreateSubscribeResponse) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
 	return ""
 }
 
-func (x *CreateSubscribeResponse) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *CreateSubscribeResponse) GetEndpoint() string {
-	if x != nil {
-		return x.Endpoint
-	}
-	return ""
-}
-
-func (x *CreateSubscribeResponse) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
-	}
-	return false
-}
-
-type UpdateSubscribeRequest struct {
+type ListSubscribeEntitiesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Id          uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	PageNum          uint64 `protobuf:"varint,1,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	PageSize         uint64 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	OrderBy          string `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	IsDescending     bool   `protobuf:"varint,4,opt,name=is_descending,json=isDescending,proto3" json:"is_descending,omitempty"`
+	KeyWords         string `protobuf:"bytes,5,opt,name=key_words,json=keyWords,proto3" json:"key_words,omitempty"`
+	SearchKey        string `protobuf:"bytes,6,opt,name=search_key,json=searchKey,proto3" json:"search_key,omitempty"`
+	Id               uint64 `protobuf:"varint,7,opt,name=id,proto3" json:"id,omitempty"`
+	Status           string `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	TemplateId       string `protobuf:"bytes,9,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	GroupId          string `protobuf:"bytes,10,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	IncludeSubgroups bool   `protobuf:"varint,11,opt,name=include_subgroups,json=includeSubgroups,proto3" json:"include_subgroups,omitempty"`
+	UpdatedAfter     int64  `protobuf:"varint,12,opt,name=updated_after,json=updatedAfter,proto3" json:"updated_after,omitempty"`
+	UpdatedBefore    int64  `protobuf:"varint,13,opt,name=updated_before,json=updatedBefore,proto3" json:"updated_before,omitempty"`
 }
 
-func (x *UpdateSubscribeRequest) Reset() {
-	*x = UpdateSubscribeRequest{}
+func (x *ListSubscribeEntitiesRequest) Reset() {
+	*x = ListSubscribeEntitiesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -962,13 +916,13 @@ func (x *UpdateSubscribeRequest) Reset() {
 	}
 }
 
-func (x *UpdateSubscribeRequest) String() string {
+func (x *ListSubscribeEntitiesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSubscribeRequest) ProtoMessage() {}
+func (*ListSubscribeEntitiesRequest) ProtoMessage() {}
 
-func (x *UpdateSubscribeRequest) ProtoReflect() protoreflect.Message {
+func (x *ListSubscribeEntitiesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -980,46 +934,116 @@ func (x *UpdateSubscribeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSubscribeRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSubscribeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListSubscribeEntitiesRequest.ProtoReflect.Descriptor instead.
+func (*ListSubscribeEntitiesRequest) Descriptor() ([]byte, []int) {
 	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *UpdateSubscribeRequest) GetTitle() string {
+func (x *ListSubscribeEntitiesRequest) GetPageNum() uint64 {
 	if x != nil {
-		return x.Title
+		return x.PageNum
+	}
+	return 0
+}
+
+func (x *ListSubscribeEntitiesRequest) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSubscribeEntitiesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
 	}
 	return ""
 }
 
-func (x *UpdateSubscribeRequest) GetDescription() string {
+func (x *ListSubscribeEntitiesRequest) GetIsDescending() bool {
 	if x != nil {
-		return x.Description
+		return x.IsDescending
+	}
+	return false
+}
+
+func (x *ListSubscribeEntitiesRequest) GetKeyWords() string {
+	if x != nil {
+		return x.KeyWords
 	}
 	return ""
 }
 
-func (x *UpdateSubscribeRequest) GetId() uint64 {
+func (x *ListSubscribeEntitiesRequest) GetSearchKey() string {
+	if x != nil {
+		return x.SearchKey
+	}
+	return ""
+}
+
+func (x *ListSubscribeEntitiesRequest) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type UpdateSubscribeResponse struct {
+func (x *ListSubscribeEntitiesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListSubscribeEntitiesRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *ListSubscribeEntitiesRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ListSubscribeEntitiesRequest) GetIncludeSubgroups() bool {
+	if x != nil {
+		return x.IncludeSubgroups
+	}
+	return false
+}
+
+func (x *ListSubscribeEntitiesRequest) GetUpdatedAfter() int64 {
+	if x != nil {
+		return x.UpdatedAfter
+	}
+	return 0
+}
+
+func (x *ListSubscribeEntitiesRequest) GetUpdatedBefore() int64 {
+	if x != nil {
+		return x.UpdatedBefore
+	}
+	return 0
+}
+
+type ListSubscribeEntitiesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Endpoint    string `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	IsDefault   bool   `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	Total    uint64    `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	PageNum  uint64    `protobuf:"varint,2,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	LastPage uint64    `protobuf:"varint,3,opt,name=last_page,json=lastPage,proto3" json:"last_page,omitempty"`
+	PageSize uint64    `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Data     []*Entity `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *UpdateSubscribeResponse) Reset() {
-	*x = UpdateSubscribeResponse{}
+func (x *ListSubscribeEntitiesResponse) Reset() {
+	*x = ListSubscribeEntitiesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1027,13 +1051,13 @@ func (x *UpdateSubscribeResponse) Reset() {
 	}
 }
 
-func (x *UpdateSubscribeResponse) String() string {
+func (x *ListSubscribeEntitiesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSubscribeResponse) ProtoMessage() {}
+func (*ListSubscribeEntitiesResponse) ProtoMessage() {}
 
-func (x *UpdateSubscribeResponse) ProtoReflect() protoreflect.Message {
+func (x *ListSubscribeEntitiesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1045,56 +1069,62 @@ func (x *UpdateSubscribeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSubscribeResponse.ProtoReflect.Descriptor instead.
-func (*UpdateSubscribeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListSubscribeEntitiesResponse.ProtoReflect.Descriptor instead.
+func (*ListSubscribeEntitiesResponse) Descriptor() ([]byte, []int) {
 	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *UpdateSubscribeResponse) GetId() uint64 {
+func (x *ListSubscribeEntitiesResponse) GetTotal() uint64 {
 	if x != nil {
-		return x.Id
+		return x.Total
 	}
 	return 0
 }
 
-func (x *UpdateSubscribeResponse) GetTitle() string {
+func (x *ListSubscribeEntitiesResponse) GetPageNum() uint64 {
 	if x != nil {
-		return x.Title
+		return x.PageNum
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateSubscribeResponse) GetDescription() string {
+func (x *ListSubscribeEntitiesResponse) GetLastPage() uint64 {
 	if x != nil {
-		return x.Description
+		return x.LastPage
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateSubscribeResponse) GetEndpoint() string {
+func (x *ListSubscribeEntitiesResponse) GetPageSize() uint64 {
 	if x != nil {
-		return x.Endpoint
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateSubscribeResponse) GetIsDefault() bool {
+func (x *ListSubscribeEntitiesResponse) GetData() []*Entity {
 	if x != nil {
-		return x.IsDefault
+		return x.Data
 	}
-	return false
+	return nil
 }
 
-type DeleteSubscribeRequest struct {
+type SubscribeObject struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Endpoint    string `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	IsDefault   bool   `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	Mode        string `protobuf:"bytes,6,opt,name=mode,proto3" json:"mode,omitempty"`
+	State       string `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`
 }
 
-func (x *DeleteSubscribeRequest) Reset() {
-	*x = DeleteSubscribeRequest{}
+func (x *SubscribeObject) Reset() {
+	*x = SubscribeObject{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1102,13 +1132,13 @@ func (x *DeleteSubscribeRequest) Reset() {
 	}
 }
 
-func (x *DeleteSubscribeRequest) String() string {
+func (x *SubscribeObject) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSubscribeRequest) ProtoMessage() {}
+func (*SubscribeObject) ProtoMessage() {}
 
-func (x *DeleteSubscribeRequest) ProtoReflect() protoreflect.Message {
+func (x *SubscribeObject) ProtoReflect() protoreflect.Message {
 	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1120,28 +1150,72 @@ func (x *DeleteSubscribeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSubscribeRequest.ProtoReflect.Descriptor instead.
-func (*DeleteSubscribeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubscribeObject.ProtoReflect.Descriptor instead.
+func (*SubscribeObject) Descriptor() ([]byte, []int) {
 	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *DeleteSubscribeRequest) GetId() uint64 {
+func (x *SubscribeObject) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type DeleteSubscribeResponse struct {
+func (x *SubscribeObject) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SubscribeObject) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SubscribeObject) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *SubscribeObject) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *SubscribeObject) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *SubscribeObject) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type AggregateOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Window   uint32 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	Scope    string `protobuf:"bytes,2,opt,name=scope,proto3" json:"scope,omitempty"`
+	Lateness uint32 `protobuf:"varint,3,opt,name=lateness,proto3" json:"lateness,omitempty"`
 }
 
-func (x *DeleteSubscribeResponse) Reset() {
-	*x = DeleteSubscribeResponse{}
+func (x *AggregateOptions) Reset() {
+	*x = AggregateOptions{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1149,13 +1223,13 @@ func (x *DeleteSubscribeResponse) Reset() {
 	}
 }
 
-func (x *DeleteSubscribeResponse) String() string {
+func (x *AggregateOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSubscribeResponse) ProtoMessage() {}
+func (*AggregateOptions) ProtoMessage() {}
 
-func (x *DeleteSubscribeResponse) ProtoReflect() protoreflect.Message {
+func (x *AggregateOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1167,28 +1241,43 @@ func (x *DeleteSubscribeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSubscribeResponse.ProtoReflect.Descriptor instead.
-func (*DeleteSubscribeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AggregateOptions.ProtoReflect.Descriptor instead.
+func (*AggregateOptions) Descriptor() ([]byte, []int) {
 	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *DeleteSubscribeResponse) GetId() uint64 {
+func (x *AggregateOptions) GetWindow() uint32 {
 	if x != nil {
-		return x.Id
+		return x.Window
 	}
 	return 0
 }
 
-type GetSubscribeRequest struct {
+func (x *AggregateOptions) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *AggregateOptions) GetLateness() uint32 {
+	if x != nil {
+		return x.Lateness
+	}
+	return 0
+}
+
+type DeadbandOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type  string  `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Value float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *GetSubscribeRequest) Reset() {
-	*x = GetSubscribeRequest{}
+func (x *DeadbandOptions) Reset() {
+	*x = DeadbandOptions{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1196,13 +1285,13 @@ func (x *GetSubscribeRequest) Reset() {
 	}
 }
 
-func (x *GetSubscribeRequest) String() string {
+func (x *DeadbandOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSubscribeRequest) ProtoMessage() {}
+func (*DeadbandOptions) ProtoMessage() {}
 
-func (x *GetSubscribeRequest) ProtoReflect() protoreflect.Message {
+func (x *DeadbandOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1214,35 +1303,41 @@ func (x *GetSubscribeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSubscribeRequest.ProtoReflect.Descriptor instead.
-func (*GetSubscribeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeadbandOptions.ProtoReflect.Descriptor instead.
+func (*DeadbandOptions) Descriptor() ([]byte, []int) {
 	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GetSubscribeRequest) GetId() uint64 {
+func (x *DeadbandOptions) GetType() string {
 	if x != nil {
-		return x.Id
+		return x.Type
 	}
-	return 0
+	return ""
 }
 
-type GetSubscribeResponse struct {
-	state         protoimpl.MessageState
+func (x *DeadbandOptions) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type CreateSubscribeRequest struct {
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Endpoint    string `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	Count       uint64 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
-	CreatedAt   int64  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt   int64  `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	IsDefault   bool   `protobuf:"varint,8,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	Title          string            `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string            `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Mode           string            `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	Aggregate      *AggregateOptions `protobuf:"bytes,4,opt,name=aggregate,proto3" json:"aggregate,omitempty"`
+	Deadband       *DeadbandOptions  `protobuf:"bytes,5,opt,name=deadband,proto3" json:"deadband,omitempty"`
+	ActiveSchedule string            `protobuf:"bytes,6,opt,name=active_schedule,json=activeSchedule,proto3" json:"active_schedule,omitempty"`
+	ExpiresAt      int64             `protobuf:"varint,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
-func (x *GetSubscribeResponse) Reset() {
-	*x = GetSubscribeResponse{}
+func (x *CreateSubscribeRequest) Reset() {
+	*x = CreateSubscribeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1250,13 +1345,13 @@ func (x *GetSubscribeResponse) Reset() {
 	}
 }
 
-func (x *GetSubscribeResponse) String() string {
+func (x *CreateSubscribeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSubscribeResponse) ProtoMessage() {}
+func (*CreateSubscribeRequest) ProtoMessage() {}
 
-func (x *GetSubscribeResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateSubscribeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1268,82 +1363,80 @@ func (x *GetSubscribeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSubscribeResponse.ProtoReflect.Descriptor instead.
-func (*GetSubscribeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateSubscribeRequest.ProtoReflect.Descriptor instead.
+func (*CreateSubscribeRequest) Descriptor() ([]byte, []int) {
 	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetSubscribeResponse) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *GetSubscribeResponse) GetTitle() string {
+func (x *CreateSubscribeRequest) GetTitle() string {
 	if x != nil {
 		return x.Title
 	}
 	return ""
 }
 
-func (x *GetSubscribeResponse) GetDescription() string {
+func (x *CreateSubscribeRequest) GetDescription() string {
 	if x != nil {
 		return x.Description
 	}
 	return ""
 }
 
-func (x *GetSubscribeResponse) GetEndpoint() string {
+func (x *CreateSubscribeRequest) GetMode() string {
 	if x != nil {
-		return x.Endpoint
+		return x.Mode
 	}
 	return ""
 }
 
-func (x *GetSubscribeResponse) GetCount() uint64 {
+func (x *CreateSubscribeRequest) GetAggregate() *AggregateOptions {
 	if x != nil {
-		return x.Count
+		return x.Aggregate
 	}
-	return 0
+	return nil
 }
 
-func (x *GetSubscribeResponse) GetCreatedAt() int64 {
+func (x *CreateSubscribeRequest) GetDeadband() *DeadbandOptions {
 	if x != nil {
-		return x.CreatedAt
+		return x.Deadband
 	}
-	return 0
+	return nil
 }
 
-func (x *GetSubscribeResponse) GetUpdatedAt() int64 {
+func (x *CreateSubscribeRequest) GetActiveSchedule() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.ActiveSchedule
 	}
-	return 0
+	return ""
 }
 
-func (x *GetSubscribeResponse) GetIsDefault() bool {
+func (x *CreateSubscribeRequest) GetExpiresAt() int64 {
 	if x != nil {
-		return x.IsDefault
+		return x.ExpiresAt
 	}
-	return false
+	return 0
 }
 
-type ListSubscribeRequest struct {
+type CreateSubscribeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PageNum      uint64 `protobuf:"varint,1,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
-	PageSize     uint64 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	OrderBy      string `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	IsDescending bool   `protobuf:"varint,4,opt,name=is_descending,json=isDescending,proto3" json:"is_descending,omitempty"`
-	KeyWords     string `protobuf:"bytes,5,opt,name=key_words,json=keyWords,proto3" json:"key_words,omitempty"`
-	SearchKey    string `protobuf:"bytes,6,opt,name=search_key,json=searchKey,proto3" json:"search_key,omitempty"`
+	Id             uint64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title          string            `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string            `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Endpoint       string            `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	IsDefault      bool              `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	Mode           string            `protobuf:"bytes,6,opt,name=mode,proto3" json:"mode,omitempty"`
+	Aggregate      *AggregateOptions `protobuf:"bytes,7,opt,name=aggregate,proto3" json:"aggregate,omitempty"`
+	Deadband       *DeadbandOptions  `protobuf:"bytes,8,opt,name=deadband,proto3" json:"deadband,omitempty"`
+	ActiveSchedule string            `protobuf:"bytes,9,opt,name=active_schedule,json=activeSchedule,proto3" json:"active_schedule,omitempty"`
+	ExpiresAt      int64             `protobuf:"varint,10,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	State          string            `protobuf:"bytes,11,opt,name=state,proto3" json:"state,omitempty"`
 }
 
-func (x *ListSubscribeRequest) Reset() {
-	*x = ListSubscribeRequest{}
+func (x *CreateSubscribeResponse) Reset() {
+	*x = CreateSubscribeResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1351,13 +1444,13 @@ func (x *ListSubscribeRequest) Reset() {
 	}
 }
 
-func (x *ListSubscribeRequest) String() string {
+func (x *CreateSubscribeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSubscribeRequest) ProtoMessage() {}
+func (*CreateSubscribeResponse) ProtoMessage() {}
 
-func (x *ListSubscribeRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateSubscribeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1369,67 +1462,105 @@ func (x *ListSubscribeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSubscribeRequest.ProtoReflect.Descriptor instead.
-func (*ListSubscribeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateSubscribeResponse.ProtoReflect.Descriptor instead.
+func (*CreateSubscribeResponse) Descriptor() ([]byte, []int) {
 	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ListSubscribeRequest) GetPageNum() uint64 {
+func (x *CreateSubscribeResponse) GetId() uint64 {
 	if x != nil {
-		return x.PageNum
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ListSubscribeRequest) GetPageSize() uint64 {
+func (x *CreateSubscribeResponse) GetTitle() string {
 	if x != nil {
-		return x.PageSize
+		return x.Title
 	}
-	return 0
+	return ""
 }
 
-func (x *ListSubscribeRequest) GetOrderBy() string {
+func (x *CreateSubscribeResponse) GetDescription() string {
 	if x != nil {
-		return x.OrderBy
+		return x.Description
 	}
 	return ""
 }
 
-func (x *ListSubscribeRequest) GetIsDescending() bool {
+func (x *CreateSubscribeResponse) GetEndpoint() string {
 	if x != nil {
-		return x.IsDescending
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *CreateSubscribeResponse) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
 	}
 	return false
 }
 
-func (x *ListSubscribeRequest) GetKeyWords() string {
+func (x *CreateSubscribeResponse) GetMode() string {
 	if x != nil {
-		return x.KeyWords
+		return x.Mode
 	}
 	return ""
 }
 
-func (x *ListSubscribeRequest) GetSearchKey() string {
+func (x *CreateSubscribeResponse) GetAggregate() *AggregateOptions {
 	if x != nil {
-		return x.SearchKey
+		return x.Aggregate
+	}
+	return nil
+}
+
+func (x *CreateSubscribeResponse) GetDeadband() *DeadbandOptions {
+	if x != nil {
+		return x.Deadband
+	}
+	return nil
+}
+
+func (x *CreateSubscribeResponse) GetActiveSchedule() string {
+	if x != nil {
+		return x.ActiveSchedule
 	}
 	return ""
 }
 
-type ListSubscribeResponse struct {
+func (x *CreateSubscribeResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *CreateSubscribeResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type UpdateSubscribeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total    uint64             `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	PageNum  uint64             `protobuf:"varint,2,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
-	LastPage uint64             `protobuf:"varint,3,opt,name=last_page,json=lastPage,proto3" json:"last_page,omitempty"`
-	PageSize uint64             `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Data     []*SubscribeObject `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty"`
+	Title          string            `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string            `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Id             uint64            `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	Mode           string            `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	Aggregate      *AggregateOptions `protobuf:"bytes,5,opt,name=aggregate,proto3" json:"aggregate,omitempty"`
+	Deadband       *DeadbandOptions  `protobuf:"bytes,6,opt,name=deadband,proto3" json:"deadband,omitempty"`
+	ActiveSchedule string            `protobuf:"bytes,7,opt,name=active_schedule,json=activeSchedule,proto3" json:"active_schedule,omitempty"`
+	ExpiresAt      int64             `protobuf:"varint,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
-func (x *ListSubscribeResponse) Reset() {
-	*x = ListSubscribeResponse{}
+func (x *UpdateSubscribeRequest) Reset() {
+	*x = UpdateSubscribeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1437,13 +1568,13 @@ func (x *ListSubscribeResponse) Reset() {
 	}
 }
 
-func (x *ListSubscribeResponse) String() string {
+func (x *UpdateSubscribeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSubscribeResponse) ProtoMessage() {}
+func (*UpdateSubscribeRequest) ProtoMessage() {}
 
-func (x *ListSubscribeResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateSubscribeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1455,73 +1586,1009 @@ func (x *ListSubscribeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSubscribeResponse.ProtoReflect.Descriptor instead.
-func (*ListSubscribeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateSubscribeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSubscribeRequest) Descriptor() ([]byte, []int) {
 	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ListSubscribeResponse) GetTotal() uint64 {
+func (x *UpdateSubscribeRequest) GetTitle() string {
 	if x != nil {
-		return x.Total
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateSubscribeRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateSubscribeRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ListSubscribeResponse) GetPageNum() uint64 {
+func (x *UpdateSubscribeRequest) GetMode() string {
 	if x != nil {
-		return x.PageNum
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *UpdateSubscribeRequest) GetAggregate() *AggregateOptions {
+	if x != nil {
+		return x.Aggregate
+	}
+	return nil
+}
+
+func (x *UpdateSubscribeRequest) GetDeadband() *DeadbandOptions {
+	if x != nil {
+		return x.Deadband
+	}
+	return nil
+}
+
+func (x *UpdateSubscribeRequest) GetActiveSchedule() string {
+	if x != nil {
+		return x.ActiveSchedule
+	}
+	return ""
+}
+
+func (x *UpdateSubscribeRequest) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
 	}
 	return 0
 }
 
-func (x *ListSubscribeResponse) GetLastPage() uint64 {
+type UpdateSubscribeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             uint64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title          string            `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string            `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Endpoint       string            `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	IsDefault      bool              `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	Mode           string            `protobuf:"bytes,6,opt,name=mode,proto3" json:"mode,omitempty"`
+	Aggregate      *AggregateOptions `protobuf:"bytes,7,opt,name=aggregate,proto3" json:"aggregate,omitempty"`
+	Deadband       *DeadbandOptions  `protobuf:"bytes,8,opt,name=deadband,proto3" json:"deadband,omitempty"`
+	ActiveSchedule string            `protobuf:"bytes,9,opt,name=active_schedule,json=activeSchedule,proto3" json:"active_schedule,omitempty"`
+	ExpiresAt      int64             `protobuf:"varint,10,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	State          string            `protobuf:"bytes,11,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (x *UpdateSubscribeResponse) Reset() {
+	*x = UpdateSubscribeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSubscribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSubscribeResponse) ProtoMessage() {}
+
+func (x *UpdateSubscribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSubscribeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSubscribeResponse) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateSubscribeResponse) GetId() uint64 {
 	if x != nil {
-		return x.LastPage
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateSubscribeResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateSubscribeResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateSubscribeResponse) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *UpdateSubscribeResponse) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *UpdateSubscribeResponse) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *UpdateSubscribeResponse) GetAggregate() *AggregateOptions {
+	if x != nil {
+		return x.Aggregate
+	}
+	return nil
+}
+
+func (x *UpdateSubscribeResponse) GetDeadband() *DeadbandOptions {
+	if x != nil {
+		return x.Deadband
+	}
+	return nil
+}
+
+func (x *UpdateSubscribeResponse) GetActiveSchedule() string {
+	if x != nil {
+		return x.ActiveSchedule
+	}
+	return ""
+}
+
+func (x *UpdateSubscribeResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *UpdateSubscribeResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type DeleteSubscribeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteSubscribeRequest) Reset() {
+	*x = DeleteSubscribeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSubscribeRequest) ProtoMessage() {}
+
+func (x *DeleteSubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSubscribeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteSubscribeRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteSubscribeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteSubscribeResponse) Reset() {
+	*x = DeleteSubscribeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSubscribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSubscribeResponse) ProtoMessage() {}
+
+func (x *DeleteSubscribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSubscribeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSubscribeResponse) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteSubscribeResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetSubscribeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetSubscribeRequest) Reset() {
+	*x = GetSubscribeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscribeRequest) ProtoMessage() {}
+
+func (x *GetSubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscribeRequest.ProtoReflect.Descriptor instead.
+func (*GetSubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetSubscribeRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetSubscribeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             uint64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title          string            `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string            `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Endpoint       string            `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Count          uint64            `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	CreatedAt      int64             `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      int64             `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	IsDefault      bool              `protobuf:"varint,8,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	Mode           string            `protobuf:"bytes,9,opt,name=mode,proto3" json:"mode,omitempty"`
+	Aggregate      *AggregateOptions `protobuf:"bytes,10,opt,name=aggregate,proto3" json:"aggregate,omitempty"`
+	Deadband       *DeadbandOptions  `protobuf:"bytes,11,opt,name=deadband,proto3" json:"deadband,omitempty"`
+	ActiveSchedule string            `protobuf:"bytes,12,opt,name=active_schedule,json=activeSchedule,proto3" json:"active_schedule,omitempty"`
+	ExpiresAt      int64             `protobuf:"varint,13,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	State          string            `protobuf:"bytes,14,opt,name=state,proto3" json:"state,omitempty"`
+	Query          []*QueryCondition `protobuf:"bytes,15,rep,name=query,proto3" json:"query,omitempty"`
+}
+
+func (x *GetSubscribeResponse) Reset() {
+	*x = GetSubscribeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSubscribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscribeResponse) ProtoMessage() {}
+
+func (x *GetSubscribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscribeResponse.ProtoReflect.Descriptor instead.
+func (*GetSubscribeResponse) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetSubscribeResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetSubscribeResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GetSubscribeResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GetSubscribeResponse) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *GetSubscribeResponse) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetSubscribeResponse) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *GetSubscribeResponse) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *GetSubscribeResponse) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *GetSubscribeResponse) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *GetSubscribeResponse) GetAggregate() *AggregateOptions {
+	if x != nil {
+		return x.Aggregate
+	}
+	return nil
+}
+
+func (x *GetSubscribeResponse) GetDeadband() *DeadbandOptions {
+	if x != nil {
+		return x.Deadband
+	}
+	return nil
+}
+
+func (x *GetSubscribeResponse) GetActiveSchedule() string {
+	if x != nil {
+		return x.ActiveSchedule
+	}
+	return ""
+}
+
+func (x *GetSubscribeResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *GetSubscribeResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *GetSubscribeResponse) GetQuery() []*QueryCondition {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+type ListSubscribeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageNum      uint64 `protobuf:"varint,1,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	PageSize     uint64 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	OrderBy      string `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	IsDescending bool   `protobuf:"varint,4,opt,name=is_descending,json=isDescending,proto3" json:"is_descending,omitempty"`
+	KeyWords     string `protobuf:"bytes,5,opt,name=key_words,json=keyWords,proto3" json:"key_words,omitempty"`
+	SearchKey    string `protobuf:"bytes,6,opt,name=search_key,json=searchKey,proto3" json:"search_key,omitempty"`
+}
+
+func (x *ListSubscribeRequest) Reset() {
+	*x = ListSubscribeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscribeRequest) ProtoMessage() {}
+
+func (x *ListSubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscribeRequest.ProtoReflect.Descriptor instead.
+func (*ListSubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListSubscribeRequest) GetPageNum() uint64 {
+	if x != nil {
+		return x.PageNum
+	}
+	return 0
+}
+
+func (x *ListSubscribeRequest) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSubscribeRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListSubscribeRequest) GetIsDescending() bool {
+	if x != nil {
+		return x.IsDescending
+	}
+	return false
+}
+
+func (x *ListSubscribeRequest) GetKeyWords() string {
+	if x != nil {
+		return x.KeyWords
+	}
+	return ""
+}
+
+func (x *ListSubscribeRequest) GetSearchKey() string {
+	if x != nil {
+		return x.SearchKey
+	}
+	return ""
+}
+
+type ListSubscribeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total    uint64             `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	PageNum  uint64             `protobuf:"varint,2,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	LastPage uint64             `protobuf:"varint,3,opt,name=last_page,json=lastPage,proto3" json:"last_page,omitempty"`
+	PageSize uint64             `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Data     []*SubscribeObject `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ListSubscribeResponse) Reset() {
+	*x = ListSubscribeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSubscribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscribeResponse) ProtoMessage() {}
+
+func (x *ListSubscribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscribeResponse.ProtoReflect.Descriptor instead.
+func (*ListSubscribeResponse) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListSubscribeResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListSubscribeResponse) GetPageNum() uint64 {
+	if x != nil {
+		return x.PageNum
+	}
+	return 0
+}
+
+func (x *ListSubscribeResponse) GetLastPage() uint64 {
+	if x != nil {
+		return x.LastPage
+	}
+	return 0
+}
+
+func (x *ListSubscribeResponse) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSubscribeResponse) GetData() []*SubscribeObject {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ChangeSubscribedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TargetId    uint64   `protobuf:"varint,2,opt,name=targetId,proto3" json:"targetId,omitempty"`
+	SelectedIds []string `protobuf:"bytes,3,rep,name=selectedIds,proto3" json:"selectedIds,omitempty"`
+	Mode        string   `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	Rollback    bool     `protobuf:"varint,5,opt,name=rollback,proto3" json:"rollback,omitempty"`
+}
+
+func (x *ChangeSubscribedRequest) Reset() {
+	*x = ChangeSubscribedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeSubscribedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeSubscribedRequest) ProtoMessage() {}
+
+func (x *ChangeSubscribedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeSubscribedRequest.ProtoReflect.Descriptor instead.
+func (*ChangeSubscribedRequest) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ChangeSubscribedRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ChangeSubscribedRequest) GetTargetId() uint64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *ChangeSubscribedRequest) GetSelectedIds() []string {
+	if x != nil {
+		return x.SelectedIds
+	}
+	return nil
+}
+
+func (x *ChangeSubscribedRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *ChangeSubscribedRequest) GetRollback() bool {
+	if x != nil {
+		return x.Rollback
+	}
+	return false
+}
+
+type ChangeSubscribedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status  string          `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Results []*EntityResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *ChangeSubscribedResponse) Reset() {
+	*x = ChangeSubscribedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeSubscribedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeSubscribedResponse) ProtoMessage() {}
+
+func (x *ChangeSubscribedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeSubscribedResponse.ProtoReflect.Descriptor instead.
+func (*ChangeSubscribedResponse) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ChangeSubscribedResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ChangeSubscribedResponse) GetResults() []*EntityResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type Entity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID         string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Status     string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Template   string `protobuf:"bytes,4,opt,name=template,proto3" json:"template,omitempty"`
+	Group      string `protobuf:"bytes,5,opt,name=group,proto3" json:"group,omitempty"`
+	UpdatedAt  int64  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	TemplateId string `protobuf:"bytes,7,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+}
+
+func (x *Entity) Reset() {
+	*x = Entity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Entity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Entity) ProtoMessage() {}
+
+func (x *Entity) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Entity.ProtoReflect.Descriptor instead.
+func (*Entity) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Entity) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *Entity) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Entity) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Entity) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *Entity) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *Entity) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Entity) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+type ValidateSubscribedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+}
+
+func (x *ValidateSubscribedRequest) Reset() {
+	*x = ValidateSubscribedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateSubscribedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateSubscribedRequest) ProtoMessage() {}
+
+func (x *ValidateSubscribedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateSubscribedRequest.ProtoReflect.Descriptor instead.
+func (*ValidateSubscribedRequest) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ValidateSubscribedRequest) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+type ValidateSubscribedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ValidateSubscribedResponse) Reset() {
+	*x = ValidateSubscribedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateSubscribedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateSubscribedResponse) ProtoMessage() {}
+
+func (x *ValidateSubscribedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ListSubscribeResponse) GetPageSize() uint64 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
+// Deprecated: Use ValidateSubscribedResponse.ProtoReflect.Descriptor instead.
+func (*ValidateSubscribedResponse) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ListSubscribeResponse) GetData() []*SubscribeObject {
+func (x *ValidateSubscribedResponse) GetStatus() string {
 	if x != nil {
-		return x.Data
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-type ChangeSubscribedRequest struct {
+type SubscribeByDeviceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	TargetId    uint64   `protobuf:"varint,2,opt,name=targetId,proto3" json:"targetId,omitempty"`
-	SelectedIds []string `protobuf:"bytes,3,rep,name=selectedIds,proto3" json:"selectedIds,omitempty"`
+	Id           string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubscribeIds []string `protobuf:"bytes,2,rep,name=subscribe_ids,json=subscribeIds,proto3" json:"subscribe_ids,omitempty"`
 }
 
-func (x *ChangeSubscribedRequest) Reset() {
-	*x = ChangeSubscribedRequest{}
+func (x *SubscribeByDeviceRequest) Reset() {
+	*x = SubscribeByDeviceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[23]
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChangeSubscribedRequest) String() string {
+func (x *SubscribeByDeviceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeSubscribedRequest) ProtoMessage() {}
+func (*SubscribeByDeviceRequest) ProtoMessage() {}
 
-func (x *ChangeSubscribedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[23]
+func (x *SubscribeByDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1532,33 +2599,26 @@ func (x *ChangeSubscribedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeSubscribedRequest.ProtoReflect.Descriptor instead.
-func (*ChangeSubscribedRequest) Descriptor() ([]byte, []int) {
-	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use SubscribeByDeviceRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeByDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *ChangeSubscribedRequest) GetId() uint64 {
+func (x *SubscribeByDeviceRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
-	return 0
-}
-
-func (x *ChangeSubscribedRequest) GetTargetId() uint64 {
-	if x != nil {
-		return x.TargetId
-	}
-	return 0
+	return ""
 }
 
-func (x *ChangeSubscribedRequest) GetSelectedIds() []string {
+func (x *SubscribeByDeviceRequest) GetSubscribeIds() []string {
 	if x != nil {
-		return x.SelectedIds
+		return x.SubscribeIds
 	}
 	return nil
 }
 
-type ChangeSubscribedResponse struct {
+type SubscribeByDeviceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1566,23 +2626,23 @@ type ChangeSubscribedResponse struct {
 	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *ChangeSubscribedResponse) Reset() {
-	*x = ChangeSubscribedResponse{}
+func (x *SubscribeByDeviceResponse) Reset() {
+	*x = SubscribeByDeviceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[24]
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChangeSubscribedResponse) String() string {
+func (x *SubscribeByDeviceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeSubscribedResponse) ProtoMessage() {}
+func (*SubscribeByDeviceResponse) ProtoMessage() {}
 
-func (x *ChangeSubscribedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[24]
+func (x *SubscribeByDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1593,49 +2653,45 @@ func (x *ChangeSubscribedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeSubscribedResponse.ProtoReflect.Descriptor instead.
-func (*ChangeSubscribedResponse) Descriptor() ([]byte, []int) {
-	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use SubscribeByDeviceResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeByDeviceResponse) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *ChangeSubscribedResponse) GetStatus() string {
+func (x *SubscribeByDeviceResponse) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
-type Entity struct {
+type QueryCondition struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID         string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Status     string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	Template   string `protobuf:"bytes,4,opt,name=template,proto3" json:"template,omitempty"`
-	Group      string `protobuf:"bytes,5,opt,name=group,proto3" json:"group,omitempty"`
-	UpdatedAt  int64  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	TemplateId string `protobuf:"bytes,7,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	Field    string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Value    string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *Entity) Reset() {
-	*x = Entity{}
+func (x *QueryCondition) Reset() {
+	*x = QueryCondition{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[25]
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Entity) String() string {
+func (x *QueryCondition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Entity) ProtoMessage() {}
+func (*QueryCondition) ProtoMessage() {}
 
-func (x *Entity) ProtoReflect() protoreflect.Message {
-	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[25]
+func (x *QueryCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1646,85 +2702,58 @@ func (x *Entity) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Entity.ProtoReflect.Descriptor instead.
-func (*Entity) Descriptor() ([]byte, []int) {
-	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *Entity) GetID() string {
-	if x != nil {
-		return x.ID
-	}
-	return ""
-}
-
-func (x *Entity) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Entity) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+// Deprecated: Use QueryCondition.ProtoReflect.Descriptor instead.
+func (*QueryCondition) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *Entity) GetTemplate() string {
+func (x *QueryCondition) GetField() string {
 	if x != nil {
-		return x.Template
+		return x.Field
 	}
 	return ""
 }
 
-func (x *Entity) GetGroup() string {
+func (x *QueryCondition) GetOperator() string {
 	if x != nil {
-		return x.Group
+		return x.Operator
 	}
 	return ""
 }
 
-func (x *Entity) GetUpdatedAt() int64 {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return 0
-}
-
-func (x *Entity) GetTemplateId() string {
+func (x *QueryCondition) GetValue() string {
 	if x != nil {
-		return x.TemplateId
+		return x.Value
 	}
 	return ""
 }
 
-type ValidateSubscribedRequest struct {
+type SetSubscribeQueryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Id         uint64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Conditions []*QueryCondition `protobuf:"bytes,2,rep,name=conditions,proto3" json:"conditions,omitempty"`
 }
 
-func (x *ValidateSubscribedRequest) Reset() {
-	*x = ValidateSubscribedRequest{}
+func (x *SetSubscribeQueryRequest) Reset() {
+	*x = SetSubscribeQueryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[26]
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ValidateSubscribedRequest) String() string {
+func (x *SetSubscribeQueryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateSubscribedRequest) ProtoMessage() {}
+func (*SetSubscribeQueryRequest) ProtoMessage() {}
 
-func (x *ValidateSubscribedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[26]
+func (x *SetSubscribeQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1735,43 +2764,52 @@ func (x *ValidateSubscribedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateSubscribedRequest.ProtoReflect.Descriptor instead.
-func (*ValidateSubscribedRequest) Descriptor() ([]byte, []int) {
-	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use SetSubscribeQueryRequest.ProtoReflect.Descriptor instead.
+func (*SetSubscribeQueryRequest) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *ValidateSubscribedRequest) GetTopic() string {
+func (x *SetSubscribeQueryRequest) GetId() uint64 {
 	if x != nil {
-		return x.Topic
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type ValidateSubscribedResponse struct {
+func (x *SetSubscribeQueryRequest) GetConditions() []*QueryCondition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+type SetSubscribeQueryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status  string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Matched uint64 `protobuf:"varint,3,opt,name=matched,proto3" json:"matched,omitempty"`
 }
 
-func (x *ValidateSubscribedResponse) Reset() {
-	*x = ValidateSubscribedResponse{}
+func (x *SetSubscribeQueryResponse) Reset() {
+	*x = SetSubscribeQueryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[27]
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ValidateSubscribedResponse) String() string {
+func (x *SetSubscribeQueryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateSubscribedResponse) ProtoMessage() {}
+func (*SetSubscribeQueryResponse) ProtoMessage() {}
 
-func (x *ValidateSubscribedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[27]
+func (x *SetSubscribeQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1782,44 +2820,63 @@ func (x *ValidateSubscribedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateSubscribedResponse.ProtoReflect.Descriptor instead.
-func (*ValidateSubscribedResponse) Descriptor() ([]byte, []int) {
-	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use SetSubscribeQueryResponse.ProtoReflect.Descriptor instead.
+func (*SetSubscribeQueryResponse) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *ValidateSubscribedResponse) GetStatus() string {
+func (x *SetSubscribeQueryResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SetSubscribeQueryResponse) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
-type SubscribeByDeviceRequest struct {
+func (x *SetSubscribeQueryResponse) GetMatched() uint64 {
+	if x != nil {
+		return x.Matched
+	}
+	return 0
+}
+
+type PreviewSubscribeQueryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	SubscribeIds []string `protobuf:"bytes,2,rep,name=subscribe_ids,json=subscribeIds,proto3" json:"subscribe_ids,omitempty"`
+	PageNum      uint64            `protobuf:"varint,1,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	PageSize     uint64            `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	OrderBy      string            `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	IsDescending bool              `protobuf:"varint,4,opt,name=is_descending,json=isDescending,proto3" json:"is_descending,omitempty"`
+	KeyWords     string            `protobuf:"bytes,5,opt,name=key_words,json=keyWords,proto3" json:"key_words,omitempty"`
+	SearchKey    string            `protobuf:"bytes,6,opt,name=search_key,json=searchKey,proto3" json:"search_key,omitempty"`
+	Conditions   []*QueryCondition `protobuf:"bytes,7,rep,name=conditions,proto3" json:"conditions,omitempty"`
 }
 
-func (x *SubscribeByDeviceRequest) Reset() {
-	*x = SubscribeByDeviceRequest{}
+func (x *PreviewSubscribeQueryRequest) Reset() {
+	*x = PreviewSubscribeQueryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[28]
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubscribeByDeviceRequest) String() string {
+func (x *PreviewSubscribeQueryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeByDeviceRequest) ProtoMessage() {}
+func (*PreviewSubscribeQueryRequest) ProtoMessage() {}
 
-func (x *SubscribeByDeviceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[28]
+func (x *PreviewSubscribeQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1830,70 +2887,137 @@ func (x *SubscribeByDeviceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeByDeviceRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeByDeviceRequest) Descriptor() ([]byte, []int) {
-	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use PreviewSubscribeQueryRequest.ProtoReflect.Descriptor instead.
+func (*PreviewSubscribeQueryRequest) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *SubscribeByDeviceRequest) GetId() string {
+func (x *PreviewSubscribeQueryRequest) GetPageNum() uint64 {
 	if x != nil {
-		return x.Id
+		return x.PageNum
+	}
+	return 0
+}
+
+func (x *PreviewSubscribeQueryRequest) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *PreviewSubscribeQueryRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
 	}
 	return ""
 }
 
-func (x *SubscribeByDeviceRequest) GetSubscribeIds() []string {
+func (x *PreviewSubscribeQueryRequest) GetIsDescending() bool {
 	if x != nil {
-		return x.SubscribeIds
+		return x.IsDescending
+	}
+	return false
+}
+
+func (x *PreviewSubscribeQueryRequest) GetKeyWords() string {
+	if x != nil {
+		return x.KeyWords
+	}
+	return ""
+}
+
+func (x *PreviewSubscribeQueryRequest) GetSearchKey() string {
+	if x != nil {
+		return x.SearchKey
+	}
+	return ""
+}
+
+func (x *PreviewSubscribeQueryRequest) GetConditions() []*QueryCondition {
+	if x != nil {
+		return x.Conditions
 	}
 	return nil
 }
 
-type SubscribeByDeviceResponse struct {
+type PreviewSubscribeQueryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Total    uint64    `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	PageNum  uint64    `protobuf:"varint,2,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	LastPage uint64    `protobuf:"varint,3,opt,name=last_page,json=lastPage,proto3" json:"last_page,omitempty"`
+	PageSize uint64    `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Data     []*Entity `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *SubscribeByDeviceResponse) Reset() {
-	*x = SubscribeByDeviceResponse{}
+func (x *PreviewSubscribeQueryResponse) Reset() {
+	*x = PreviewSubscribeQueryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[29]
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubscribeByDeviceResponse) String() string {
+func (x *PreviewSubscribeQueryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeByDeviceResponse) ProtoMessage() {}
-
-func (x *SubscribeByDeviceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[29]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (*PreviewSubscribeQueryResponse) ProtoMessage() {}
+
+func (x *PreviewSubscribeQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewSubscribeQueryResponse.ProtoReflect.Descriptor instead.
+func (*PreviewSubscribeQueryResponse) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PreviewSubscribeQueryResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PreviewSubscribeQueryResponse) GetPageNum() uint64 {
+	if x != nil {
+		return x.PageNum
+	}
+	return 0
+}
+
+func (x *PreviewSubscribeQueryResponse) GetLastPage() uint64 {
+	if x != nil {
+		return x.LastPage
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use SubscribeByDeviceResponse.ProtoReflect.Descriptor instead.
-func (*SubscribeByDeviceResponse) Descriptor() ([]byte, []int) {
-	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{29}
+func (x *PreviewSubscribeQueryResponse) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
 }
 
-func (x *SubscribeByDeviceResponse) GetStatus() string {
+func (x *PreviewSubscribeQueryResponse) GetData() []*Entity {
 	if x != nil {
-		return x.Status
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
 var File_api_subscribe_v1_subscribe_proto protoreflect.FileDescriptor
@@ -1916,489 +3040,976 @@ var file_api_subscribe_v1_subscribe_proto_rawDesc = []byte{
 	0x02, 0x69, 0x64, 0x12, 0x2f, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18,
 	0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe5, 0xae, 0x9e, 0xe4,
 	0xbd, 0x93, 0x69, 0x64, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x69, 0x65, 0x73, 0x22, 0x6a, 0x0a, 0x1e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49,
-	0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9,
-	0x98, 0x85, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x22, 0x6f, 0x0a, 0x20, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74,
-	0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93,
-	0xe7, 0xbb, 0x84, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x73, 0x22, 0x6d, 0x0a, 0x21, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e,
-	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49,
-	0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9,
-	0x98, 0x85, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x22, 0x6c, 0x0a, 0x20, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74,
-	0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0xa8, 0xa1, 0xe5, 0x9e, 0x8b,
-	0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x22, 0x6d,
-	0x0a, 0x21, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74,
-	0x69, 0x65, 0x73, 0x42, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
-	0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7,
-	0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x7d, 0x0a,
-	0x1f, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69,
-	0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41,
-	0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x3b, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x09, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x32, 0x1a, 0xe5, 0x8f, 0x96, 0xe6, 0xb6, 0x88, 0xe8, 0xae,
-	0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x9a, 0x84, 0xe8, 0xae, 0xbe, 0xe5, 0xa4, 0x87, 0x49, 0x44, 0xe4,
-	0xbb, 0xac, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x6c, 0x0a, 0x20,
-	0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74,
-	0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41,
-	0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x8a, 0xb6, 0xe6,
-	0x80, 0x81, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3a, 0x0a, 0x19, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xbe, 0xe5, 0xa4, 0x87,
-	0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3b, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xbe, 0xe5, 0xa4, 0x87, 0x49, 0x44, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0xe0, 0x02, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0e, 0xe0, 0x41, 0x02, 0x92, 0x41, 0x08, 0x32, 0x06,
-	0xe9, 0xa1, 0xb5, 0xe6, 0x95, 0xb0, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12,
-	0x31, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x14, 0xe0, 0x41, 0x02, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0xaf, 0x8f, 0xe9,
-	0xa1, 0xb5, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
-	0x7a, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xe0, 0x41, 0x01, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe6, 0x8e,
-	0x92, 0xe5, 0xba, 0x8f, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x33, 0x0a,
-	0x0d, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x08, 0x42, 0x0e, 0xe0, 0x41, 0x01, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe5, 0x80,
-	0x92, 0xe5, 0xba, 0x8f, 0x52, 0x0c, 0x69, 0x73, 0x44, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xe0, 0x41, 0x01, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe5,
-	0x85, 0xb3, 0xe9, 0x94, 0xae, 0xe5, 0xad, 0x97, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x57, 0x6f, 0x72,
-	0x64, 0x73, 0x12, 0x33, 0x0a, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xe0, 0x41, 0x01, 0x92, 0x41, 0x0e, 0x32, 0x0c,
-	0xe5, 0x85, 0xb3, 0xe9, 0x94, 0xae, 0xe5, 0xad, 0x97, 0xe5, 0x80, 0xbc, 0x52, 0x09, 0x73, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x07, 0x20,
+	0x74, 0x69, 0x65, 0x73, 0x22, 0xc6, 0x01, 0x0a, 0x1e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85,
-	0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0x94, 0x02, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0e, 0xe0, 0x41, 0x02, 0x92, 0x41, 0x08, 0x32,
-	0x06, 0xe6, 0x80, 0xbb, 0xe6, 0x95, 0xb0, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x29,
-	0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x0e, 0xe0, 0x41, 0x02, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe9, 0xa1, 0xb5, 0xe6, 0x95, 0xb0,
-	0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x2e, 0x0a, 0x09, 0x6c, 0x61, 0x73,
-	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x11, 0xe0, 0x41,
-	0x02, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe4, 0xb8, 0x8a, 0xe4, 0xb8, 0x80, 0xe9, 0xa1, 0xb5, 0x52,
-	0x08, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x70, 0x61, 0x67,
-	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x14, 0xe0, 0x41,
-	0x02, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0xaf, 0x8f, 0xe9, 0xa1, 0xb5, 0xe6, 0x95, 0xb0, 0xe9,
-	0x87, 0x8f, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x3f, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98,
-	0x85, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xfa, 0x01,
-	0x0a, 0x0f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2,
+	0xe9, 0x98, 0x85, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x5a, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x1b, 0xe6, 0xaf, 0x8f, 0xe4, 0xb8, 0xaa, 0xe5,
+	0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe7, 0x9a, 0x84, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0xbb,
+	0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x6f, 0x0a,
+	0x20, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92,
 	0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x27, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe5, 0x90, 0x8d, 0xe7,
-	0xa7, 0xb0, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11,
-	0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf,
-	0xb0, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f,
-	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x65, 0x6e, 0x64,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12,
-	0x39, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x08, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6,
-	0xe4, 0xb8, 0xba, 0xe9, 0xbb, 0x98, 0xe8, 0xae, 0xa4, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x52,
-	0x09, 0x69, 0x73, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0x76, 0x0a, 0x16, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x12, 0x2c, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe7, 0xbb, 0x84,
+	0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0xc9,
+	0x01, 0x0a, 0x21, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85,
-	0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x33, 0x0a,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe6,
-	0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x82, 0x02, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d,
+	0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x5a,
+	0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42,
+	0x20, 0x92, 0x41, 0x1d, 0x32, 0x1b, 0xe6, 0xaf, 0x8f, 0xe4, 0xb8, 0xaa, 0xe5, 0xae, 0x9e, 0xe4,
+	0xbd, 0x93, 0xe7, 0x9a, 0x84, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0xbb, 0x93, 0xe6, 0x9e,
+	0x9c, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x6c, 0x0a, 0x20, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42,
+	0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32,
+	0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a,
+	0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x11, 0x92,
+	0x41, 0x0e, 0x32, 0x0c, 0xe6, 0xa8, 0xa1, 0xe5, 0x9e, 0x8b, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8,
+	0x52, 0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x22, 0xc9, 0x01, 0x0a, 0x21, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d,
 	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32,
-	0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a,
-	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41,
-	0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52,
-	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e,
-	0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x08, 0x65,
-	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0x92,
-	0x41, 0x10, 0x32, 0x0e, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a,
-	0x69, 0x73, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe4, 0xb8, 0xba,
-	0xe9, 0xbb, 0x98, 0xe8, 0xae, 0xa4, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x52, 0x09, 0x69, 0x73,
-	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0x95, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe5, 0x90,
-	0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe6, 0x8f, 0x8f,
-	0xe8, 0xbf, 0xb0, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41,
-	0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0x82, 0x02, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae,
-	0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x69,
-	0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c,
+	0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92,
+	0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x5a, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x1b,
+	0xe6, 0xaf, 0x8f, 0xe4, 0xb8, 0xaa, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe7, 0x9a, 0x84, 0xe8,
+	0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x22, 0x7d, 0x0a, 0x1f, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85,
+	0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x32, 0x1a, 0xe5,
+	0x8f, 0x96, 0xe6, 0xb6, 0x88, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x9a, 0x84, 0xe8, 0xae,
+	0xbe, 0xe5, 0xa4, 0x87, 0x49, 0x44, 0xe4, 0xbb, 0xac, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x22, 0x6c, 0x0a, 0x20, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85,
+	0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2,
+	0xe9, 0x98, 0x85, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0xc8, 0x01, 0x0a, 0x22, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98,
+	0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x16, 0x92, 0x41, 0x13, 0x32, 0x11, 0xe5, 0xae,
+	0x9e, 0xe4, 0xbd, 0x93, 0xe7, 0xbb, 0x84, 0x49, 0x44, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52,
+	0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x53, 0x0a, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x5f, 0x73, 0x75, 0x62, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x26, 0x92, 0x41, 0x23, 0x32, 0x21, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5,
+	0x8c, 0x85, 0xe5, 0x90, 0xab, 0xe5, 0xad, 0x90, 0xe5, 0x88, 0x86, 0xe7, 0xbb, 0x84, 0xe4, 0xb8,
+	0xad, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x52, 0x10, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x53, 0x75, 0x62, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0xcb, 0x01, 0x0a,
+	0x23, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x60, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x26, 0x92, 0x41, 0x23, 0x32, 0x21,
+	0xe6, 0xaf, 0x8f, 0xe4, 0xb8, 0xaa, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe7, 0x9a, 0x84, 0xe5,
+	0x8f, 0x96, 0xe6, 0xb6, 0x88, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0xbb, 0x93, 0xe6, 0x9e,
+	0x9c, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x70, 0x0a, 0x22, 0x55, 0x6e,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x42, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41,
+	0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x2b, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42,
+	0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d, 0xbf, 0x49, 0x44, 0xe5, 0x88,
+	0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x22, 0xcb, 0x01, 0x0a,
+	0x23, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x60, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x26, 0x92, 0x41, 0x23, 0x32, 0x21,
+	0xe6, 0xaf, 0x8f, 0xe4, 0xb8, 0xaa, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe7, 0x9a, 0x84, 0xe5,
+	0x8f, 0x96, 0xe6, 0xb6, 0x88, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0xbb, 0x93, 0xe6, 0x9e,
+	0x9c, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xdb, 0x01, 0x0a, 0x0c, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe5, 0xae,
+	0x9e, 0xe4, 0xbd, 0x93, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x7f, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x67, 0x92, 0x41, 0x64, 0x32,
+	0x62, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x3a, 0x20, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53,
+	0x28, 0xe6, 0x88, 0x90, 0xe5, 0x8a, 0x9f, 0x29, 0x2c, 0x20, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x55,
+	0x42, 0x53, 0x43, 0x52, 0x49, 0x42, 0x45, 0x44, 0x28, 0xe6, 0x9c, 0xaa, 0xe8, 0xae, 0xa2, 0xe9,
+	0x98, 0x85, 0x29, 0x2c, 0x20, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x53, 0x55, 0x42,
+	0x53, 0x43, 0x52, 0x49, 0x42, 0x45, 0x44, 0x28, 0xe5, 0xb7, 0xb2, 0xe8, 0xae, 0xa2, 0xe9, 0x98,
+	0x85, 0x29, 0x2c, 0x20, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x28, 0xe5, 0xa4, 0xb1, 0xe8,
+	0xb4, 0xa5, 0x29, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x32, 0x0c, 0xe5, 0xa4, 0xb1, 0xe8, 0xb4, 0xa5, 0xe5, 0x8e, 0x9f, 0xe5, 0x9b, 0xa0, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3a, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xbe, 0xe5, 0xa4, 0x87, 0x49, 0x44,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x3b, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d,
+	0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xbe, 0xe5, 0xa4, 0x87, 0x49, 0x44, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x9d, 0x06, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x29, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x0e, 0xe0, 0x41, 0x02, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe9, 0xa1,
+	0xb5, 0xe6, 0x95, 0xb0, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x31, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x14, 0xe0, 0x41, 0x02, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0xaf, 0x8f, 0xe9, 0xa1, 0xb5,
+	0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x43, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x28, 0xe0, 0x41, 0x01, 0x92, 0x41, 0x22, 0x32, 0x20, 0xe6, 0x8e, 0x92, 0xe5,
+	0xba, 0x8f, 0x3a, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x2c, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x52, 0x07, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x33, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x73, 0x63,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x0e, 0xe0, 0x41,
+	0x01, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe5, 0x80, 0x92, 0xe5, 0xba, 0x8f, 0x52, 0x0c, 0x69, 0x73,
+	0x44, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x09, 0x6b, 0x65,
+	0x79, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xe0,
+	0x41, 0x01, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe5, 0x85, 0xb3, 0xe9, 0x94, 0xae, 0xe5, 0xad, 0x97,
+	0x52, 0x08, 0x6b, 0x65, 0x79, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x33, 0x0a, 0x0a, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14,
+	0xe0, 0x41, 0x01, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x85, 0xb3, 0xe9, 0x94, 0xae, 0xe5, 0xad,
+	0x97, 0xe5, 0x80, 0xbc, 0x52, 0x09, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12,
+	0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a,
+	0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3d,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x25,
+	0xe0, 0x41, 0x01, 0x92, 0x41, 0x1f, 0x32, 0x1d, 0xe5, 0x9c, 0xa8, 0xe7, 0xba, 0xbf, 0xe7, 0x8a,
+	0xb6, 0xe6, 0x80, 0x81, 0x3a, 0x20, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x2c, 0x20, 0x6f, 0x66,
+	0x66, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31, 0x0a,
+	0x0b, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x10, 0xe0, 0x41, 0x01, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0xa8, 0xa1, 0xe6,
+	0x9d, 0xbf, 0x49, 0x44, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64,
+	0x12, 0x2b, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x10, 0xe0, 0x41, 0x01, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe5, 0x88, 0x86, 0xe7,
+	0xbb, 0x84, 0x49, 0x44, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x56, 0x0a,
+	0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x75, 0x62, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x42, 0x29, 0xe0, 0x41, 0x01, 0x92, 0x41, 0x23,
+	0x32, 0x21, 0xe5, 0x88, 0x86, 0xe7, 0xbb, 0x84, 0xe8, 0xbf, 0x87, 0xe6, 0xbb, 0xa4, 0xe6, 0x98,
+	0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x8c, 0x85, 0xe5, 0x90, 0xab, 0xe5, 0xad, 0x90, 0xe5, 0x88, 0x86,
+	0xe7, 0xbb, 0x84, 0x52, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x75, 0x62, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x53, 0x0a, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x42, 0x2e, 0xe0, 0x41,
+	0x01, 0x92, 0x41, 0x28, 0x32, 0x26, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe6, 0x97, 0xb6, 0xe9,
+	0x97, 0xb4, 0xe4, 0xb8, 0x8d, 0xe6, 0x97, 0xa9, 0xe4, 0xba, 0x8e, 0x28, 0xe6, 0xaf, 0xab, 0xe7,
+	0xa7, 0x92, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe6, 0x88, 0xb3, 0x29, 0x52, 0x0c, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x55, 0x0a, 0x0e, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x2e, 0xe0, 0x41, 0x01, 0x92, 0x41, 0x28, 0x32, 0x26, 0xe6, 0x9b, 0xb4, 0xe6,
+	0x96, 0xb0, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe4, 0xb8, 0x8d, 0xe6, 0x99, 0x9a, 0xe4, 0xba,
+	0x8e, 0x28, 0xe6, 0xaf, 0xab, 0xe7, 0xa7, 0x92, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe6, 0x88,
+	0xb3, 0x29, 0x52, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x22, 0x94, 0x02, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x0e, 0xe0, 0x41, 0x02, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe6, 0x80, 0xbb, 0xe6,
+	0x95, 0xb0, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x29, 0x0a, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0e, 0xe0, 0x41, 0x02,
+	0x92, 0x41, 0x08, 0x32, 0x06, 0xe9, 0xa1, 0xb5, 0xe6, 0x95, 0xb0, 0x52, 0x07, 0x70, 0x61, 0x67,
+	0x65, 0x4e, 0x75, 0x6d, 0x12, 0x2e, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x11, 0xe0, 0x41, 0x02, 0x92, 0x41, 0x0b, 0x32,
+	0x09, 0xe4, 0xb8, 0x8a, 0xe4, 0xb8, 0x80, 0xe9, 0xa1, 0xb5, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x14, 0xe0, 0x41, 0x02, 0x92, 0x41, 0x0e, 0x32,
+	0x0c, 0xe6, 0xaf, 0x8f, 0xe9, 0xa1, 0xb5, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x3f, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42,
+	0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe5, 0xae, 0x9e, 0xe4,
+	0xbd, 0x93, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xf3, 0x02, 0x0a, 0x0f, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1d, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8,
+	0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32,
+	0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c,
+	0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x08, 0x65, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0x92, 0x41, 0x10,
+	0x32, 0x0e, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x69, 0x73,
+	0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1a,
+	0x92, 0x41, 0x17, 0x32, 0x15, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe4, 0xb8, 0xba, 0xe9, 0xbb,
+	0x98, 0xe8, 0xae, 0xa4, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85,
+	0xe6, 0xa8, 0xa1, 0xe5, 0xbc, 0x8f, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x50, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3a, 0x92, 0x41, 0x37,
+	0x32, 0x35, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x3a, 0x20,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x28, 0xe6, 0x8e, 0xa8, 0xe9, 0x80, 0x81, 0xe4, 0xb8, 0xad,
+	0x29, 0x2c, 0x20, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x28, 0xe6, 0x9a, 0x82, 0xe5, 0x81, 0x9c,
+	0xe6, 0x8e, 0xa8, 0xe9, 0x80, 0x81, 0x29, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0xe8,
+	0x01, 0x0a, 0x10, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x1c, 0x92, 0x41, 0x19, 0x32, 0x17, 0xe6, 0xbb, 0x9a, 0xe5, 0x8a, 0xa8,
+	0xe7, 0xaa, 0x97, 0xe5, 0x8f, 0xa3, 0xe6, 0x97, 0xb6, 0xe9, 0x95, 0xbf, 0x28, 0xe7, 0xa7, 0x92,
+	0x29, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x5b, 0x0a, 0x05, 0x73, 0x63, 0x6f,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x45, 0x92, 0x41, 0x42, 0x32, 0x40, 0xe8,
+	0x81, 0x9a, 0xe5, 0x90, 0x88, 0xe8, 0x8c, 0x83, 0xe5, 0x9b, 0xb4, 0x3a, 0x20, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x28, 0xe6, 0x8c, 0x89, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x2c, 0x20, 0xe9,
+	0xbb, 0x98, 0xe8, 0xae, 0xa4, 0x29, 0x2c, 0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x28, 0xe6, 0x95, 0xb4, 0xe4, 0xb8, 0xaa, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x29, 0x52,
+	0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x41, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x65,
+	0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x25, 0x92, 0x41, 0x22, 0x32, 0x20, 0xe5,
+	0x85, 0x81, 0xe8, 0xae, 0xb8, 0xe8, 0xbf, 0x9f, 0xe5, 0x88, 0xb0, 0xe6, 0x95, 0xb0, 0xe6, 0x8d,
+	0xae, 0xe7, 0x9a, 0x84, 0xe6, 0x97, 0xb6, 0xe9, 0x95, 0xbf, 0x28, 0xe7, 0xa7, 0x92, 0x29, 0x52,
+	0x08, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x22, 0xb3, 0x01, 0x0a, 0x0f, 0x44, 0x65,
+	0x61, 0x64, 0x62, 0x61, 0x6e, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4e, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3a, 0x92, 0x41, 0x37,
+	0x32, 0x35, 0xe6, 0xad, 0xbb, 0xe5, 0x8c, 0xba, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x3a, 0x20,
+	0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x28, 0xe7, 0xbb, 0x9d, 0xe5, 0xaf, 0xb9, 0xe5,
+	0x80, 0xbc, 0x29, 0x2c, 0x20, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x28, 0xe7, 0x99, 0xbe,
+	0xe5, 0x88, 0x86, 0xe6, 0xaf, 0x94, 0x29, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x50, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x3a, 0x92, 0x41,
+	0x37, 0x32, 0x35, 0xe6, 0xad, 0xbb, 0xe5, 0x8c, 0xba, 0xe9, 0x98, 0x88, 0xe5, 0x80, 0xbc, 0x2c,
+	0x20, 0xe6, 0x95, 0xb0, 0xe5, 0x80, 0xbc, 0xe5, 0xb1, 0x9e, 0xe6, 0x80, 0xa7, 0xe5, 0x8f, 0x98,
+	0xe5, 0x8c, 0x96, 0xe8, 0xb6, 0x85, 0xe8, 0xbf, 0x87, 0xe8, 0xaf, 0xa5, 0xe5, 0x80, 0xbc, 0xe6,
+	0x89, 0x8d, 0xe6, 0x8e, 0xa8, 0xe9, 0x80, 0x81, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0xfe, 0x04, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c,
 	0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x05, 0x74, 0x69,
 	0x74, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8,
 	0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32,
-	0x0e, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52,
-	0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x69, 0x73, 0x5f,
-	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1a, 0x92,
-	0x41, 0x17, 0x32, 0x15, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe4, 0xb8, 0xba, 0xe9, 0xbb, 0x98,
-	0xe8, 0xae, 0xa4, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x22, 0x37, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32,
-	0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0x38, 0x0a,
-	0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0x92, 0x41, 0x35, 0x32, 0x33, 0xe8, 0xae, 0xa2,
+	0xe9, 0x98, 0x85, 0xe6, 0xa8, 0xa1, 0xe5, 0xbc, 0x8f, 0x3a, 0x20, 0x72, 0x65, 0x61, 0x6c, 0x74,
+	0x69, 0x6d, 0x65, 0x28, 0xe9, 0xbb, 0x98, 0xe8, 0xae, 0xa4, 0x29, 0x2c, 0x20, 0x61, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2c, 0x20, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x59, 0x0a, 0x09, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x17, 0x92,
+	0x41, 0x14, 0x32, 0x12, 0xe8, 0x81, 0x9a, 0xe5, 0x90, 0x88, 0xe6, 0xa8, 0xa1, 0xe5, 0xbc, 0x8f,
+	0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0x52, 0x09, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x12, 0x62, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x62, 0x61, 0x6e, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x62, 0x61, 0x6e, 0x64, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x23, 0x92, 0x41, 0x20, 0x32, 0x1e, 0xe5, 0x8f, 0x98,
+	0xe5, 0x8c, 0x96, 0xe6, 0x8e, 0xa8, 0xe9, 0x80, 0x81, 0xe6, 0xa8, 0xa1, 0xe5, 0xbc, 0x8f, 0xe6,
+	0xad, 0xbb, 0xe5, 0x8c, 0xba, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0x52, 0x08, 0x64, 0x65, 0x61,
+	0x64, 0x62, 0x61, 0x6e, 0x64, 0x12, 0x83, 0x01, 0x0a, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x5a, 0x92, 0x41, 0x57, 0x32, 0x55, 0xe7, 0x94, 0x9f, 0xe6, 0x95, 0x88, 0xe6, 0x97, 0xb6, 0xe6,
+	0xae, 0xb5, 0x28, 0x63, 0x72, 0x6f, 0x6e, 0xe8, 0xa1, 0xa8, 0xe8, 0xbe, 0xbe, 0xe5, 0xbc, 0x8f,
+	0x29, 0x2c, 0x20, 0xe5, 0x8c, 0xb9, 0xe9, 0x85, 0x8d, 0xe7, 0x9a, 0x84, 0xe5, 0x88, 0x86, 0xe9,
+	0x92, 0x9f, 0xe5, 0x86, 0x85, 0xe6, 0x8e, 0xa8, 0xe9, 0x80, 0x81, 0xe6, 0x95, 0xb0, 0xe6, 0x8d,
+	0xae, 0x2c, 0x20, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe4,
+	0xb8, 0x80, 0xe7, 0x9b, 0xb4, 0xe7, 0x94, 0x9f, 0xe6, 0x95, 0x88, 0x52, 0x0e, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x73, 0x0a, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x54, 0x92, 0x41, 0x51, 0x32, 0x4f, 0xe8, 0xbf, 0x87, 0xe6, 0x9c, 0x9f, 0xe6, 0x97, 0xb6, 0xe9,
+	0x97, 0xb4, 0x28, 0xe7, 0xa7, 0x92, 0xe7, 0xba, 0xa7, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe6,
+	0x88, 0xb3, 0x29, 0x2c, 0x20, 0xe8, 0xbf, 0x87, 0xe6, 0x9c, 0x9f, 0xe5, 0x90, 0x8e, 0xe8, 0xae,
+	0xa2, 0xe9, 0x98, 0x85, 0xe8, 0x87, 0xaa, 0xe5, 0x8a, 0xa8, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4,
+	0x2c, 0x20, 0x30, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe6, 0xb0, 0xb8, 0xe4, 0xb8, 0x8d, 0xe8,
+	0xbf, 0x87, 0xe6, 0x9c, 0x9f, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x22, 0xdc, 0x06, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8,
+	0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32,
+	0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c,
+	0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x08, 0x65, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0x92, 0x41, 0x10,
+	0x32, 0x0e, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x69, 0x73,
+	0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1a,
+	0x92, 0x41, 0x17, 0x32, 0x15, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe4, 0xb8, 0xba, 0xe9, 0xbb,
+	0x98, 0xe8, 0xae, 0xa4, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x4c, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x38, 0x92, 0x41, 0x35, 0x32, 0x33, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85,
+	0xe6, 0xa8, 0xa1, 0xe5, 0xbc, 0x8f, 0x3a, 0x20, 0x72, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65,
+	0x28, 0xe9, 0xbb, 0x98, 0xe8, 0xae, 0xa4, 0x29, 0x2c, 0x20, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x2c, 0x20, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x04, 0x6d,
+	0x6f, 0x64, 0x65, 0x12, 0x59, 0x0a, 0x09, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32,
+	0x12, 0xe8, 0x81, 0x9a, 0xe5, 0x90, 0x88, 0xe6, 0xa8, 0xa1, 0xe5, 0xbc, 0x8f, 0xe9, 0x85, 0x8d,
+	0xe7, 0xbd, 0xae, 0x52, 0x09, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x62,
+	0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x62, 0x61, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x62, 0x61, 0x6e, 0x64, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x42, 0x23, 0x92, 0x41, 0x20, 0x32, 0x1e, 0xe5, 0x8f, 0x98, 0xe5, 0x8c, 0x96,
+	0xe6, 0x8e, 0xa8, 0xe9, 0x80, 0x81, 0xe6, 0xa8, 0xa1, 0xe5, 0xbc, 0x8f, 0xe6, 0xad, 0xbb, 0xe5,
+	0x8c, 0xba, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x62, 0x61,
+	0x6e, 0x64, 0x12, 0x83, 0x01, 0x0a, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5a, 0x92, 0x41,
+	0x57, 0x32, 0x55, 0xe7, 0x94, 0x9f, 0xe6, 0x95, 0x88, 0xe6, 0x97, 0xb6, 0xe6, 0xae, 0xb5, 0x28,
+	0x63, 0x72, 0x6f, 0x6e, 0xe8, 0xa1, 0xa8, 0xe8, 0xbe, 0xbe, 0xe5, 0xbc, 0x8f, 0x29, 0x2c, 0x20,
+	0xe5, 0x8c, 0xb9, 0xe9, 0x85, 0x8d, 0xe7, 0x9a, 0x84, 0xe5, 0x88, 0x86, 0xe9, 0x92, 0x9f, 0xe5,
+	0x86, 0x85, 0xe6, 0x8e, 0xa8, 0xe9, 0x80, 0x81, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x2c, 0x20,
+	0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe4, 0xb8, 0x80, 0xe7,
+	0x9b, 0xb4, 0xe7, 0x94, 0x9f, 0xe6, 0x95, 0x88, 0x52, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x73, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x42, 0x54, 0x92, 0x41,
+	0x51, 0x32, 0x4f, 0xe8, 0xbf, 0x87, 0xe6, 0x9c, 0x9f, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x28,
+	0xe7, 0xa7, 0x92, 0xe7, 0xba, 0xa7, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe6, 0x88, 0xb3, 0x29,
+	0x2c, 0x20, 0xe8, 0xbf, 0x87, 0xe6, 0x9c, 0x9f, 0xe5, 0x90, 0x8e, 0xe8, 0xae, 0xa2, 0xe9, 0x98,
+	0x85, 0xe8, 0x87, 0xaa, 0xe5, 0x8a, 0xa8, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0x2c, 0x20, 0x30,
+	0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe6, 0xb0, 0xb8, 0xe4, 0xb8, 0x8d, 0xe8, 0xbf, 0x87, 0xe6,
+	0x9c, 0x9f, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x50, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3a, 0x92, 0x41,
+	0x37, 0x32, 0x35, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x3a,
+	0x20, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x28, 0xe6, 0x8e, 0xa8, 0xe9, 0x80, 0x81, 0xe4, 0xb8,
+	0xad, 0x29, 0x2c, 0x20, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x28, 0xe6, 0x9a, 0x82, 0xe5, 0x81,
+	0x9c, 0xe6, 0x8e, 0xa8, 0xe9, 0x80, 0x81, 0x29, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22,
+	0xd1, 0x05, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c,
+	0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8,
+	0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03,
 	0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98,
-	0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0x34, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32,
-	0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0x98, 0x03,
-	0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49,
-	0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98,
-	0x85, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x33,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85,
-	0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe8, 0xae, 0xa2, 0xe9,
-	0x98, 0x85, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x04, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85,
-	0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x03, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe5, 0x88,
-	0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12,
-	0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe6, 0x97, 0xb6, 0xe9,
-	0x97, 0xb4, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a,
-	0x0a, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4c, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0x92, 0x41, 0x35, 0x32, 0x33, 0xe8, 0xae, 0xa2, 0xe9,
+	0x98, 0x85, 0xe6, 0xa8, 0xa1, 0xe5, 0xbc, 0x8f, 0x3a, 0x20, 0x72, 0x65, 0x61, 0x6c, 0x74, 0x69,
+	0x6d, 0x65, 0x28, 0xe9, 0xbb, 0x98, 0xe8, 0xae, 0xa4, 0x29, 0x2c, 0x20, 0x61, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x2c, 0x20, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x59, 0x0a, 0x09, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x17, 0x92, 0x41,
+	0x14, 0x32, 0x12, 0xe8, 0x81, 0x9a, 0xe5, 0x90, 0x88, 0xe6, 0xa8, 0xa1, 0xe5, 0xbc, 0x8f, 0xe9,
+	0x85, 0x8d, 0xe7, 0xbd, 0xae, 0x52, 0x09, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x12, 0x62, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x62, 0x61, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x62, 0x61, 0x6e, 0x64, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x23, 0x92, 0x41, 0x20, 0x32, 0x1e, 0xe5, 0x8f, 0x98, 0xe5,
+	0x8c, 0x96, 0xe6, 0x8e, 0xa8, 0xe9, 0x80, 0x81, 0xe6, 0xa8, 0xa1, 0xe5, 0xbc, 0x8f, 0xe6, 0xad,
+	0xbb, 0xe5, 0x8c, 0xba, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64,
+	0x62, 0x61, 0x6e, 0x64, 0x12, 0x9d, 0x01, 0x0a, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x74,
+	0x92, 0x41, 0x71, 0x32, 0x6f, 0xe7, 0x94, 0x9f, 0xe6, 0x95, 0x88, 0xe6, 0x97, 0xb6, 0xe6, 0xae,
+	0xb5, 0x28, 0x63, 0x72, 0x6f, 0x6e, 0xe8, 0xa1, 0xa8, 0xe8, 0xbe, 0xbe, 0xe5, 0xbc, 0x8f, 0x29,
+	0x2c, 0x20, 0xe5, 0x8c, 0xb9, 0xe9, 0x85, 0x8d, 0xe7, 0x9a, 0x84, 0xe5, 0x88, 0x86, 0xe9, 0x92,
+	0x9f, 0xe5, 0x86, 0x85, 0xe6, 0x8e, 0xa8, 0xe9, 0x80, 0x81, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae,
+	0x2c, 0x20, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe4, 0xb8,
+	0x8d, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0x2c, 0x20, 0x22, 0x2d, 0x22, 0xe8, 0xa1, 0xa8, 0xe7,
+	0xa4, 0xba, 0xe5, 0x8f, 0x96, 0xe6, 0xb6, 0x88, 0xe7, 0x94, 0x9f, 0xe6, 0x95, 0x88, 0xe6, 0x97,
+	0xb6, 0xe6, 0xae, 0xb5, 0x52, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x12, 0x8c, 0x01, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x42, 0x6d, 0x92, 0x41, 0x6a, 0x32, 0x68,
+	0xe8, 0xbf, 0x87, 0xe6, 0x9c, 0x9f, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x28, 0xe7, 0xa7, 0x92,
+	0xe7, 0xba, 0xa7, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe6, 0x88, 0xb3, 0x29, 0x2c, 0x20, 0xe8,
+	0xbf, 0x87, 0xe6, 0x9c, 0x9f, 0xe5, 0x90, 0x8e, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe8, 0x87,
+	0xaa, 0xe5, 0x8a, 0xa8, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0x2c, 0x20, 0x30, 0xe8, 0xa1, 0xa8,
+	0xe7, 0xa4, 0xba, 0xe4, 0xb8, 0x8d, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0x2c, 0x20, 0x2d, 0x31,
+	0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe5, 0x8f, 0x96, 0xe6, 0xb6, 0x88, 0xe8, 0xbf, 0x87, 0xe6,
+	0x9c, 0x9f, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x41, 0x74, 0x22, 0xdc, 0x06, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a,
+	0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92,
+	0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x08,
+	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13,
+	0x92, 0x41, 0x10, 0x32, 0x0e, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x39, 0x0a,
+	0x0a, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
 	0x08, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe4, 0xb8,
 	0xba, 0xe9, 0xbb, 0x98, 0xe8, 0xae, 0xa4, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x52, 0x09, 0x69,
-	0x73, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xb9, 0x02, 0x0a, 0x14, 0x4c, 0x69, 0x73,
-	0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x29, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x42, 0x0e, 0xe0, 0x41, 0x02, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe9, 0xa1, 0xb5,
-	0xe6, 0x95, 0xb0, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x31, 0x0a, 0x09,
-	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42,
-	0x14, 0xe0, 0x41, 0x02, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0xaf, 0x8f, 0xe9, 0xa1, 0xb5, 0xe6,
-	0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
-	0x29, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0e, 0xe0, 0x41, 0x01, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe6, 0x8e, 0x92, 0xe5, 0xba,
-	0x8f, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x33, 0x0a, 0x0d, 0x69, 0x73,
-	0x5f, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x42, 0x0e, 0xe0, 0x41, 0x01, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe5, 0x80, 0x92, 0xe5, 0xba,
-	0x8f, 0x52, 0x0c, 0x69, 0x73, 0x44, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12,
-	0x2e, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x11, 0xe0, 0x41, 0x01, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe5, 0x85, 0xb3, 0xe9,
-	0x94, 0xae, 0xe5, 0xad, 0x97, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12,
-	0x33, 0x0a, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x14, 0xe0, 0x41, 0x01, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x85, 0xb3,
-	0xe9, 0x94, 0xae, 0xe5, 0xad, 0x97, 0xe5, 0x80, 0xbc, 0x52, 0x09, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x4b, 0x65, 0x79, 0x22, 0x8f, 0x02, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24,
-	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0e, 0xe0,
-	0x41, 0x02, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe6, 0x80, 0xbb, 0xe6, 0x95, 0xb0, 0x52, 0x05, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x29, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0e, 0xe0, 0x41, 0x02, 0x92, 0x41, 0x08, 0x32, 0x06,
-	0xe9, 0xa1, 0xb5, 0xe6, 0x95, 0xb0, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12,
-	0x2e, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x11, 0xe0, 0x41, 0x02, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe4, 0xb8, 0x8a, 0xe4,
-	0xb8, 0x80, 0xe9, 0xa1, 0xb5, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12,
-	0x31, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x14, 0xe0, 0x41, 0x02, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0xaf, 0x8f, 0xe9,
-	0xa1, 0xb5, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
-	0x7a, 0x65, 0x12, 0x42, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xac, 0x01, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x73, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x4c, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0x92, 0x41, 0x35, 0x32, 0x33, 0xe8, 0xae, 0xa2,
+	0xe9, 0x98, 0x85, 0xe6, 0xa8, 0xa1, 0xe5, 0xbc, 0x8f, 0x3a, 0x20, 0x72, 0x65, 0x61, 0x6c, 0x74,
+	0x69, 0x6d, 0x65, 0x28, 0xe9, 0xbb, 0x98, 0xe8, 0xae, 0xa4, 0x29, 0x2c, 0x20, 0x61, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2c, 0x20, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x59, 0x0a, 0x09, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x17, 0x92,
+	0x41, 0x14, 0x32, 0x12, 0xe8, 0x81, 0x9a, 0xe5, 0x90, 0x88, 0xe6, 0xa8, 0xa1, 0xe5, 0xbc, 0x8f,
+	0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0x52, 0x09, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x12, 0x62, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x62, 0x61, 0x6e, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x62, 0x61, 0x6e, 0x64, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x23, 0x92, 0x41, 0x20, 0x32, 0x1e, 0xe5, 0x8f, 0x98,
+	0xe5, 0x8c, 0x96, 0xe6, 0x8e, 0xa8, 0xe9, 0x80, 0x81, 0xe6, 0xa8, 0xa1, 0xe5, 0xbc, 0x8f, 0xe6,
+	0xad, 0xbb, 0xe5, 0x8c, 0xba, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0x52, 0x08, 0x64, 0x65, 0x61,
+	0x64, 0x62, 0x61, 0x6e, 0x64, 0x12, 0x83, 0x01, 0x0a, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x5a, 0x92, 0x41, 0x57, 0x32, 0x55, 0xe7, 0x94, 0x9f, 0xe6, 0x95, 0x88, 0xe6, 0x97, 0xb6, 0xe6,
+	0xae, 0xb5, 0x28, 0x63, 0x72, 0x6f, 0x6e, 0xe8, 0xa1, 0xa8, 0xe8, 0xbe, 0xbe, 0xe5, 0xbc, 0x8f,
+	0x29, 0x2c, 0x20, 0xe5, 0x8c, 0xb9, 0xe9, 0x85, 0x8d, 0xe7, 0x9a, 0x84, 0xe5, 0x88, 0x86, 0xe9,
+	0x92, 0x9f, 0xe5, 0x86, 0x85, 0xe6, 0x8e, 0xa8, 0xe9, 0x80, 0x81, 0xe6, 0x95, 0xb0, 0xe6, 0x8d,
+	0xae, 0x2c, 0x20, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe4,
+	0xb8, 0x80, 0xe7, 0x9b, 0xb4, 0xe7, 0x94, 0x9f, 0xe6, 0x95, 0x88, 0x52, 0x0e, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x73, 0x0a, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x54, 0x92, 0x41, 0x51, 0x32, 0x4f, 0xe8, 0xbf, 0x87, 0xe6, 0x9c, 0x9f, 0xe6, 0x97, 0xb6, 0xe9,
+	0x97, 0xb4, 0x28, 0xe7, 0xa7, 0x92, 0xe7, 0xba, 0xa7, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe6,
+	0x88, 0xb3, 0x29, 0x2c, 0x20, 0xe8, 0xbf, 0x87, 0xe6, 0x9c, 0x9f, 0xe5, 0x90, 0x8e, 0xe8, 0xae,
+	0xa2, 0xe9, 0x98, 0x85, 0xe8, 0x87, 0xaa, 0xe5, 0x8a, 0xa8, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4,
+	0x2c, 0x20, 0x30, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe6, 0xb0, 0xb8, 0xe4, 0xb8, 0x8d, 0xe8,
+	0xbf, 0x87, 0xe6, 0x9c, 0x9f, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x12, 0x50, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x3a, 0x92, 0x41, 0x37, 0x32, 0x35, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x8a, 0xb6, 0xe6,
+	0x80, 0x81, 0x3a, 0x20, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x28, 0xe6, 0x8e, 0xa8, 0xe9, 0x80,
+	0x81, 0xe4, 0xb8, 0xad, 0x29, 0x2c, 0x20, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x28, 0xe6, 0x9a,
+	0x82, 0xe5, 0x81, 0x9c, 0xe6, 0x8e, 0xa8, 0xe9, 0x80, 0x81, 0x29, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x22, 0x37, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8,
+	0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0x38, 0x0a, 0x17, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49,
+	0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0x34, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8,
+	0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0xe9, 0x08, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe5,
+	0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe6, 0x8f,
+	0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2f, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85,
+	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe6, 0x95,
+	0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe5, 0x88, 0x9b, 0xe5,
+	0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe8, 0xae,
+	0xa2, 0xe9, 0x98, 0x85, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x69,
+	0x73, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x42,
+	0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe4, 0xb8, 0xba, 0xe9,
+	0xbb, 0x98, 0xe8, 0xae, 0xa4, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x52, 0x09, 0x69, 0x73, 0x44,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x4c, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0x92, 0x41, 0x35, 0x32, 0x33, 0xe8, 0xae, 0xa2, 0xe9, 0x98,
+	0x85, 0xe6, 0xa8, 0xa1, 0xe5, 0xbc, 0x8f, 0x3a, 0x20, 0x72, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d,
+	0x65, 0x28, 0xe9, 0xbb, 0x98, 0xe8, 0xae, 0xa4, 0x29, 0x2c, 0x20, 0x61, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x2c, 0x20, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x12, 0x59, 0x0a, 0x09, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x17, 0x92, 0x41, 0x14,
+	0x32, 0x12, 0xe8, 0x81, 0x9a, 0xe5, 0x90, 0x88, 0xe6, 0xa8, 0xa1, 0xe5, 0xbc, 0x8f, 0xe9, 0x85,
+	0x8d, 0xe7, 0xbd, 0xae, 0x52, 0x09, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12,
+	0x62, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x62, 0x61, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x62, 0x61, 0x6e, 0x64, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x42, 0x23, 0x92, 0x41, 0x20, 0x32, 0x1e, 0xe5, 0x8f, 0x98, 0xe5, 0x8c,
+	0x96, 0xe6, 0x8e, 0xa8, 0xe9, 0x80, 0x81, 0xe6, 0xa8, 0xa1, 0xe5, 0xbc, 0x8f, 0xe6, 0xad, 0xbb,
+	0xe5, 0x8c, 0xba, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x62,
+	0x61, 0x6e, 0x64, 0x12, 0x83, 0x01, 0x0a, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5a, 0x92,
+	0x41, 0x57, 0x32, 0x55, 0xe7, 0x94, 0x9f, 0xe6, 0x95, 0x88, 0xe6, 0x97, 0xb6, 0xe6, 0xae, 0xb5,
+	0x28, 0x63, 0x72, 0x6f, 0x6e, 0xe8, 0xa1, 0xa8, 0xe8, 0xbe, 0xbe, 0xe5, 0xbc, 0x8f, 0x29, 0x2c,
+	0x20, 0xe5, 0x8c, 0xb9, 0xe9, 0x85, 0x8d, 0xe7, 0x9a, 0x84, 0xe5, 0x88, 0x86, 0xe9, 0x92, 0x9f,
+	0xe5, 0x86, 0x85, 0xe6, 0x8e, 0xa8, 0xe9, 0x80, 0x81, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x2c,
+	0x20, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe4, 0xb8, 0x80,
+	0xe7, 0x9b, 0xb4, 0xe7, 0x94, 0x9f, 0xe6, 0x95, 0x88, 0x52, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x73, 0x0a, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x42, 0x54, 0x92,
+	0x41, 0x51, 0x32, 0x4f, 0xe8, 0xbf, 0x87, 0xe6, 0x9c, 0x9f, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4,
+	0x28, 0xe7, 0xa7, 0x92, 0xe7, 0xba, 0xa7, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe6, 0x88, 0xb3,
+	0x29, 0x2c, 0x20, 0xe8, 0xbf, 0x87, 0xe6, 0x9c, 0x9f, 0xe5, 0x90, 0x8e, 0xe8, 0xae, 0xa2, 0xe9,
+	0x98, 0x85, 0xe8, 0x87, 0xaa, 0xe5, 0x8a, 0xa8, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0x2c, 0x20,
+	0x30, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe6, 0xb0, 0xb8, 0xe4, 0xb8, 0x8d, 0xe8, 0xbf, 0x87,
+	0xe6, 0x9c, 0x9f, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x50,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3a, 0x92,
+	0x41, 0x37, 0x32, 0x35, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81,
+	0x3a, 0x20, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x28, 0xe6, 0x8e, 0xa8, 0xe9, 0x80, 0x81, 0xe4,
+	0xb8, 0xad, 0x29, 0x2c, 0x20, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x28, 0xe6, 0x9a, 0x82, 0xe5,
+	0x81, 0x9c, 0xe6, 0x8e, 0xa8, 0xe9, 0x80, 0x81, 0x29, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x75, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x3d, 0x92, 0x41, 0x3a, 0x32, 0x38, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe6, 0x9f,
+	0xa5, 0xe8, 0xaf, 0xa2, 0xe6, 0x9d, 0xa1, 0xe4, 0xbb, 0xb6, 0x2c, 0x20, 0xe4, 0xb8, 0xba, 0xe7,
+	0xa9, 0xba, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe6, 0x89, 0x8b, 0xe5, 0x8a, 0xa8, 0xe7, 0xae,
+	0xa1, 0xe7, 0x90, 0x86, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93,
+	0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0xb9, 0x02, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x29, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x0e, 0xe0, 0x41, 0x02, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe9, 0xa1, 0xb5, 0xe6,
+	0x95, 0xb0, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x31, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x14,
+	0xe0, 0x41, 0x02, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0xaf, 0x8f, 0xe9, 0xa1, 0xb5, 0xe6, 0x95,
+	0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x29,
+	0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0e, 0xe0, 0x41, 0x01, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe6, 0x8e, 0x92, 0xe5, 0xba, 0x8f,
+	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x33, 0x0a, 0x0d, 0x69, 0x73, 0x5f,
+	0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x0e, 0xe0, 0x41, 0x01, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe5, 0x80, 0x92, 0xe5, 0xba, 0x8f,
+	0x52, 0x0c, 0x69, 0x73, 0x44, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x2e,
+	0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x11, 0xe0, 0x41, 0x01, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe5, 0x85, 0xb3, 0xe9, 0x94,
+	0xae, 0xe5, 0xad, 0x97, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x33,
+	0x0a, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x14, 0xe0, 0x41, 0x01, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x85, 0xb3, 0xe9,
+	0x94, 0xae, 0xe5, 0xad, 0x97, 0xe5, 0x80, 0xbc, 0x52, 0x09, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x4b, 0x65, 0x79, 0x22, 0x8f, 0x02, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0e, 0xe0, 0x41,
+	0x02, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe6, 0x80, 0xbb, 0xe6, 0x95, 0xb0, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x29, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0e, 0xe0, 0x41, 0x02, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe9,
+	0xa1, 0xb5, 0xe6, 0x95, 0xb0, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x2e,
+	0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x11, 0xe0, 0x41, 0x02, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe4, 0xb8, 0x8a, 0xe4, 0xb8,
+	0x80, 0xe9, 0xa1, 0xb5, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x31,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x14, 0xe0, 0x41, 0x02, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0xaf, 0x8f, 0xe9, 0xa1,
+	0xb5, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x42, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xc4, 0x02, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x23, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x13, 0x92,
+	0x41, 0x10, 0x32, 0x0e, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85,
+	0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2f, 0x0a, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe7,
+	0x9b, 0xae, 0xe6, 0xa0, 0x87, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x08, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x73, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x19, 0x92, 0x41,
+	0x16, 0x32, 0x14, 0xe8, 0xa2, 0xab, 0xe7, 0xa7, 0xbb, 0xe5, 0x8a, 0xa8, 0xe7, 0x9a, 0x84, 0xe8,
+	0xae, 0xbe, 0xe5, 0xa4, 0x87, 0x49, 0x44, 0x52, 0x0b, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x49, 0x64, 0x73, 0x12, 0x3b, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x27, 0x92, 0x41, 0x24, 0x32, 0x22, 0x6d, 0x6f, 0x76, 0x65, 0x28, 0xe7, 0xa7,
+	0xbb, 0xe5, 0x8a, 0xa8, 0x2c, 0x20, 0xe9, 0xbb, 0x98, 0xe8, 0xae, 0xa4, 0x29, 0x2c, 0x20, 0x63,
+	0x6f, 0x70, 0x79, 0x28, 0xe5, 0xa4, 0x8d, 0xe5, 0x88, 0xb6, 0x29, 0x52, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x12, 0x59, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x42, 0x3d, 0x92, 0x41, 0x3a, 0x32, 0x38, 0x43, 0x6f, 0x72, 0x65, 0x20, 0xe8,
+	0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe5, 0x8f, 0x98, 0xe6, 0x9b, 0xb4, 0xe5, 0xa4, 0xb1, 0xe8, 0xb4,
+	0xa5, 0xe6, 0x97, 0xb6, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x9b, 0x9e, 0xe6, 0xbb, 0x9a,
+	0xe8, 0xaf, 0xa5, 0xe8, 0xae, 0xbe, 0xe5, 0xa4, 0x87, 0xe7, 0x9a, 0x84, 0xe5, 0x8f, 0x98, 0xe6,
+	0x9b, 0xb4, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x22, 0x9b, 0x01, 0x0a,
+	0x18, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c,
+	0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x54, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0xe6, 0xaf, 0x8f, 0xe4,
+	0xb8, 0xaa, 0xe8, 0xae, 0xbe, 0xe5, 0xa4, 0x87, 0xe7, 0x9a, 0x84, 0xe7, 0xbb, 0x93, 0xe6, 0x9e,
+	0x9c, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x98, 0x02, 0x0a, 0x06, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x69, 0x64,
+	0x52, 0x02, 0x49, 0x44, 0x12, 0x1f, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe7, 0x8a, 0xb6, 0xe6,
+	0x80, 0x81, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x08, 0x74, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41,
+	0x08, 0x32, 0x06, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d, 0xbf, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x08, 0x92, 0x41, 0x05, 0x32, 0x03, 0xe7, 0xbb, 0x84, 0x52, 0x05, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x12, 0x30, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x9b,
+	0xb4, 0xe6, 0x96, 0xb0, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2e, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32,
+	0x08, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d, 0xbf, 0x69, 0x64, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x49, 0x64, 0x22, 0x3d, 0x0a, 0x19, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
 	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x23, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x13,
-	0x92, 0x41, 0x10, 0x32, 0x0e, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe8, 0xae, 0xa2, 0xe9, 0x98,
-	0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2f, 0x0a, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e,
-	0xe7, 0x9b, 0xae, 0xe6, 0xa0, 0x87, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x08,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x73, 0x65, 0x6c, 0x65,
-	0x63, 0x74, 0x65, 0x64, 0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x19, 0x92,
-	0x41, 0x16, 0x32, 0x14, 0xe8, 0xa2, 0xab, 0xe7, 0xa7, 0xbb, 0xe5, 0x8a, 0xa8, 0xe7, 0x9a, 0x84,
-	0xe8, 0xae, 0xbe, 0xe5, 0xa4, 0x87, 0x49, 0x44, 0x52, 0x0b, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
-	0x65, 0x64, 0x49, 0x64, 0x73, 0x22, 0x45, 0x0a, 0x18, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xe7, 0x8a,
-	0xb6, 0xe6, 0x80, 0x81, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x98, 0x02, 0x0a,
-	0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93,
-	0x69, 0x64, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1f, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe5, 0x90, 0x8d, 0xe7, 0xa7,
-	0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe7, 0x8a,
-	0xb6, 0xe6, 0x80, 0x81, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x08,
-	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b,
-	0x92, 0x41, 0x08, 0x32, 0x06, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d, 0xbf, 0x52, 0x08, 0x74, 0x65, 0x6d,
-	0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0x92, 0x41, 0x05, 0x32, 0x03, 0xe7, 0xbb, 0x84, 0x52, 0x05,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x30, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c,
-	0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x09, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2e, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41,
-	0x0a, 0x32, 0x08, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d, 0xbf, 0x69, 0x64, 0x52, 0x0a, 0x74, 0x65, 0x6d,
-	0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x22, 0x3d, 0x0a, 0x19, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0a, 0x92, 0x41, 0x07, 0x32, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x52,
-	0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x22, 0x41, 0x0a, 0x1a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe7, 0x8a, 0xb6, 0xe6, 0x80,
-	0x81, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x73, 0x0a, 0x18, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x79, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xbe, 0xe5, 0xa4, 0x87, 0x69, 0x64,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x13, 0x92, 0x41, 0x10,
-	0x32, 0x0e, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x69, 0x64, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8,
-	0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x49, 0x64, 0x73, 0x22, 0x40,
-	0x0a, 0x19, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x79, 0x44, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08,
-	0x32, 0x06, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x32, 0xd6, 0x17, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0xf8,
-	0x01, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69,
-	0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x12, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79,
-	0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42,
-	0x79, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7b, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x3a, 0x01,
-	0x2a, 0x92, 0x41, 0x55, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12,
-	0x23, 0xe9, 0x80, 0x9a, 0xe8, 0xbf, 0x87, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x69, 0x64, 0xe5,
-	0x88, 0x97, 0xe8, 0xa1, 0xa8, 0xe6, 0xb7, 0xbb, 0xe5, 0x8a, 0xa0, 0xe5, 0x88, 0xb0, 0xe8, 0xae,
-	0xa2, 0xe9, 0x98, 0x85, 0x2a, 0x16, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45,
-	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x4a, 0x0b, 0x0a, 0x03,
-	0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0xfd, 0x01, 0x0a, 0x19, 0x53, 0x75,
+	0x73, 0x74, 0x12, 0x20, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0a, 0x92, 0x41, 0x07, 0x32, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x05, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x22, 0x41, 0x0a, 0x1a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x73, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x42, 0x79, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xbe, 0xe5, 0xa4, 0x87, 0x69, 0x64, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x38, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e,
+	0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x69, 0x64, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x0c,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x49, 0x64, 0x73, 0x22, 0x40, 0x0a, 0x19,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x79, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06,
+	0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xc2,
+	0x01, 0x0a, 0x0e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x50, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x3a, 0x92, 0x41, 0x37, 0x32, 0x35, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x2c, 0x20, 0xe5,
+	0xa6, 0x82, 0x20, 0x62, 0x61, 0x73, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x74, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x2c, 0x20, 0x73, 0x79, 0x73, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x2e, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x61, 0x74, 0x68, 0x52, 0x05, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x3e, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0x92, 0x41, 0x1f, 0x32, 0x1d, 0xe6, 0x93, 0x8d, 0xe4,
+	0xbd, 0x9c, 0xe7, 0xac, 0xa6, 0x2c, 0x20, 0xe5, 0xa6, 0x82, 0x20, 0x24, 0x65, 0x71, 0x2c, 0x20,
+	0x24, 0x77, 0x69, 0x6c, 0x64, 0x63, 0x61, 0x72, 0x64, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x08, 0x92, 0x41, 0x05, 0x32, 0x03, 0xe5, 0x80, 0xbc, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0xdb, 0x01, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41,
+	0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x9f, 0x01, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x5d, 0x92, 0x41, 0x5a, 0x32, 0x58, 0xe5, 0xae, 0x9e,
+	0xe4, 0xbd, 0x93, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe6, 0x9d, 0xa1, 0xe4, 0xbb, 0xb6, 0x2c,
+	0x20, 0xe8, 0xae, 0xbe, 0xe7, 0xbd, 0xae, 0xe5, 0x90, 0x8e, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85,
+	0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe7, 0x94, 0xb1, 0xe6, 0x9d, 0xa1, 0xe4, 0xbb, 0xb6, 0xe5,
+	0x86, 0xb3, 0xe5, 0xae, 0x9a, 0x2c, 0x20, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe8, 0xa1, 0xa8,
+	0xe7, 0xa4, 0xba, 0xe6, 0x81, 0xa2, 0xe5, 0xa4, 0x8d, 0xe6, 0x89, 0x8b, 0xe5, 0x8a, 0xa8, 0xe7,
+	0xae, 0xa1, 0xe7, 0x90, 0x86, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x9b, 0x01, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a,
+	0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b,
+	0x92, 0x41, 0x08, 0x32, 0x06, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x1b, 0xe5, 0xbd, 0x93, 0xe5, 0x89,
+	0x8d, 0xe5, 0x8c, 0xb9, 0xe9, 0x85, 0x8d, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93,
+	0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x22,
+	0x9c, 0x03, 0x0a, 0x1c, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x29, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x0e, 0xe0, 0x41, 0x02, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe9, 0xa1, 0xb5, 0xe6,
+	0x95, 0xb0, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x31, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x14,
+	0xe0, 0x41, 0x02, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0xaf, 0x8f, 0xe9, 0xa1, 0xb5, 0xe6, 0x95,
+	0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x29,
+	0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0e, 0xe0, 0x41, 0x01, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe6, 0x8e, 0x92, 0xe5, 0xba, 0x8f,
+	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x33, 0x0a, 0x0d, 0x69, 0x73, 0x5f,
+	0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x0e, 0xe0, 0x41, 0x01, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe5, 0x80, 0x92, 0xe5, 0xba, 0x8f,
+	0x52, 0x0c, 0x69, 0x73, 0x44, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x2e,
+	0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x11, 0xe0, 0x41, 0x01, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe5, 0x85, 0xb3, 0xe9, 0x94,
+	0xae, 0xe5, 0xad, 0x97, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x33,
+	0x0a, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x14, 0xe0, 0x41, 0x01, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x85, 0xb3, 0xe9,
+	0x94, 0xae, 0xe5, 0xad, 0x97, 0xe5, 0x80, 0xbc, 0x52, 0x09, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x4b, 0x65, 0x79, 0x12, 0x59, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12,
+	0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe6, 0x9d, 0xa1, 0xe4,
+	0xbb, 0xb6, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x97,
+	0x02, 0x0a, 0x1d, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x24, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x0e, 0xe0, 0x41, 0x02, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe6, 0x80, 0xbb, 0xe6, 0x95, 0xb0, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x29, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e,
+	0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0e, 0xe0, 0x41, 0x02, 0x92, 0x41, 0x08,
+	0x32, 0x06, 0xe9, 0xa1, 0xb5, 0xe6, 0x95, 0xb0, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75,
+	0x6d, 0x12, 0x2e, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x11, 0xe0, 0x41, 0x02, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe4, 0xb8,
+	0x8a, 0xe4, 0xb8, 0x80, 0xe9, 0xa1, 0xb5, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x12, 0x31, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x14, 0xe0, 0x41, 0x02, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0xaf,
+	0x8f, 0xe9, 0xa1, 0xb5, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x42, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x14, 0x92, 0x41,
+	0x11, 0x32, 0x0f, 0xe5, 0x8c, 0xb9, 0xe9, 0x85, 0x8d, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x9e, 0xe4,
+	0xbd, 0x93, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0xe6, 0x1f, 0x0a, 0x09, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0xf8, 0x01, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44,
+	0x73, 0x12, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x30, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18, 0x2f, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x55, 0x0a, 0x09, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x23, 0xe9, 0x80, 0x9a, 0xe8, 0xbf, 0x87, 0xe5,
+	0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x69, 0x64, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0xe6, 0xb7, 0xbb,
+	0xe5, 0x8a, 0xa0, 0xe5, 0x88, 0xb0, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x2a, 0x16, 0x73, 0x75,
 	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42,
-	0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x79, 0x49, 0x44, 0x73, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f,
+	0x4b, 0x12, 0xfd, 0x01, 0x0a, 0x19, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12,
+	0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x77, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b,
+	0x22, 0x16, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x53, 0x0a, 0x09,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x1e, 0xe9, 0x80, 0x9a, 0xe8, 0xbf,
+	0x87, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe7, 0xbb, 0x84, 0xe6, 0xb7, 0xbb, 0xe5, 0x8a, 0xa0,
+	0xe5, 0x88, 0xb0, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x2a, 0x19, 0x73, 0x75, 0x62, 0x73, 0x63,
 	0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73,
-	0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x77, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
-	0x3a, 0x01, 0x2a, 0x92, 0x41, 0x53, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x12, 0x1e, 0xe9, 0x80, 0x9a, 0xe8, 0xbf, 0x87, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe7,
-	0xbb, 0x84, 0xe6, 0xb7, 0xbb, 0xe5, 0x8a, 0xa0, 0xe5, 0x88, 0xb0, 0xe8, 0xae, 0xa2, 0xe9, 0x98,
-	0x85, 0x2a, 0x19, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69,
-	0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x4a, 0x0b, 0x0a, 0x03,
-	0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0xfa, 0x01, 0x0a, 0x19, 0x53, 0x75,
+	0x6f, 0x75, 0x70, 0x73, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f,
+	0x4b, 0x12, 0xfa, 0x01, 0x0a, 0x19, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x12,
+	0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b,
+	0x22, 0x16, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x50, 0x0a, 0x09,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x1b, 0xe9, 0x80, 0x9a, 0xe8, 0xbf,
+	0x87, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d, 0xbf, 0xe6, 0xb7, 0xbb, 0xe5, 0x8a, 0xa0, 0xe5, 0x88, 0xb0,
+	0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x2a, 0x19, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0xf0,
+	0x01, 0x0a, 0x18, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x12, 0x31, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x6d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x22, 0x1f, 0x2f, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x92, 0x41,
+	0x40, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x0c, 0xe5, 0x8f,
+	0x96, 0xe6, 0xb6, 0x88, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x2a, 0x18, 0x75, 0x6e, 0x73, 0x75,
 	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42,
-	0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x12, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x4d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73,
-	0x42, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
-	0x3a, 0x01, 0x2a, 0x92, 0x41, 0x50, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x12, 0x1b, 0xe9, 0x80, 0x9a, 0xe8, 0xbf, 0x87, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d, 0xbf, 0xe6,
-	0xb7, 0xbb, 0xe5, 0x8a, 0xa0, 0xe5, 0x88, 0xb0, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x2a, 0x19,
-	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
-	0x73, 0x42, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30,
-	0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0xf0, 0x01, 0x0a, 0x18, 0x55, 0x6e, 0x73, 0x75, 0x62,
+	0x79, 0x49, 0x44, 0x73, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f,
+	0x4b, 0x12, 0x89, 0x02, 0x0a, 0x1b, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x12, 0x34, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62,
 	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79,
-	0x49, 0x44, 0x73, 0x12, 0x31, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7d,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x22, 0x1d, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x52, 0x0a, 0x09, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x1b, 0xe9, 0x80, 0x9a, 0xe8, 0xbf, 0x87, 0xe5, 0xae,
+	0x9e, 0xe4, 0xbd, 0x93, 0xe7, 0xbb, 0x84, 0xe5, 0x8f, 0x96, 0xe6, 0xb6, 0x88, 0xe8, 0xae, 0xa2,
+	0xe9, 0x98, 0x85, 0x2a, 0x1b, 0x75, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0x86, 0x02,
+	0x0a, 0x1b, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x12, 0x34, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
 	0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49,
-	0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6d, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x24, 0x22, 0x1f, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x7b,
-	0x69, 0x64, 0x7d, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x64, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x40, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x12, 0x0c, 0xe5, 0x8f, 0x96, 0xe6, 0xb6, 0x88, 0xe8, 0xae, 0xa2, 0xe9,
-	0x98, 0x85, 0x2a, 0x18, 0x75, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45,
-	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x4a, 0x0b, 0x0a, 0x03,
-	0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0xdc, 0x01, 0x0a, 0x12, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44,
-	0x12, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69,
-	0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
+	0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7a, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x22, 0x22, 0x1d, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x7b,
+	0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x4f, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x12, 0x18, 0xe9, 0x80, 0x9a, 0xe8, 0xbf, 0x87, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d, 0xbf,
+	0xe5, 0x8f, 0x96, 0xe6, 0xb6, 0x88, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x2a, 0x1b, 0x75, 0x6e,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x42, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30,
+	0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0xdc, 0x01, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x12, 0x2b, 0x2e,
 	0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31,
 	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42,
-	0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6b, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x10, 0x2a, 0x0e, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b,
-	0x69, 0x64, 0x7d, 0x92, 0x41, 0x52, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x12, 0x24, 0xe4, 0xbb, 0x8e, 0xe6, 0x89, 0x80, 0xe6, 0x9c, 0x89, 0xe8, 0xae, 0xa2, 0xe9,
-	0x98, 0x85, 0xe4, 0xb8, 0xad, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe8, 0xae, 0xa2, 0xe9, 0x98,
-	0x85, 0xe8, 0xae, 0xbe, 0xe5, 0xa4, 0x87, 0x2a, 0x12, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45,
-	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x4a, 0x0b, 0x0a, 0x03, 0x32,
-	0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0xf1, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73,
-	0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69,
-	0x65, 0x73, 0x12, 0x2e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x77, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x22, 0x1d, 0x2f, 0x73, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x4c,
-	0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x1b, 0xe6, 0x9f, 0xa5,
-	0xe8, 0xaf, 0xa2, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x9e, 0xe4,
-	0xbd, 0x93, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x2a, 0x15, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x4a,
-	0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0xb7, 0x01, 0x0a,
-	0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x12, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x22, 0x0a, 0x2f,
-	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x37, 0x0a,
-	0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x0c, 0xe5, 0x88, 0x9b, 0xe5,
-	0xbb, 0xba, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x2a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30,
-	0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0xbc, 0x01, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x28, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x54, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x32, 0x0f, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x37, 0x0a, 0x09,
-	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x0c, 0xe6, 0x9b, 0xb4, 0xe6, 0x96,
-	0xb0, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x2a, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12,
-	0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0xb9, 0x01, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x2a, 0x0f, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x37, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x0c, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe8, 0xae, 0xa2,
-	0xe9, 0x98, 0x85, 0x2a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f,
-	0x4b, 0x12, 0xad, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x12, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10,
+	0x2a, 0x0e, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
+	0x92, 0x41, 0x52, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x24,
+	0xe4, 0xbb, 0x8e, 0xe6, 0x89, 0x80, 0xe6, 0x9c, 0x89, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe4,
+	0xb8, 0xad, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe8, 0xae,
+	0xbe, 0xe5, 0xa4, 0x87, 0x2a, 0x12, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12,
+	0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0xf1, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12,
+	0x2e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x77, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x22, 0x1d, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x4c, 0x0a, 0x09, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x1b, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2,
+	0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe5,
+	0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x2a, 0x15, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x4a, 0x0b, 0x0a, 0x03,
+	0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0xb7, 0x01, 0x0a, 0x0f, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x28, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x4f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x22, 0x0a, 0x2f, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x37, 0x0a, 0x09, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x0c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe8,
+	0xae, 0xa2, 0xe9, 0x98, 0x85, 0x2a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a,
+	0x02, 0x4f, 0x4b, 0x12, 0xbc, 0x01, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x14, 0x32, 0x0f, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x37, 0x0a, 0x09, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x0c, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe8, 0xae,
+	0xa2, 0xe9, 0x98, 0x85, 0x2a, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02,
+	0x4f, 0x4b, 0x12, 0xb9, 0x01, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x11, 0x2a, 0x0f, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x37, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x12, 0x0c, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85,
+	0x2a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0xad,
+	0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12,
+	0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x34, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x0c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe8, 0xae, 0xa2,
+	0xe9, 0x98, 0x85, 0x2a, 0x0c, 0x67, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0xba,
+	0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x12, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
 	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x4e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x73, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x34, 0x0a, 0x09, 0x73,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x0c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2,
-	0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x2a, 0x0c, 0x67, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f,
-	0x4b, 0x12, 0xba, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x12, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x58, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x22, 0x0f, 0x2f, 0x73,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x3a, 0x01, 0x2a,
-	0x92, 0x41, 0x3b, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x12,
-	0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe5, 0x88, 0x97, 0xe8,
-	0xa1, 0xa8, 0x2a, 0x0d, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0xbf,
-	0x01, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x64, 0x12, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x14, 0x1a, 0x0f, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x7b,
-	0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x37, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x12, 0x0c, 0xe7, 0xa7, 0xbb, 0xe5, 0x8a, 0xa8, 0xe8, 0xae, 0xa2, 0xe9,
-	0x98, 0x85, 0x2a, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b,
-	0x12, 0xd2, 0x01, 0x0a, 0x12, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x12, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x65, 0x22, 0x58, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x22, 0x0f, 0x2f, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x3b,
+	0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x12, 0xe6, 0x9f, 0xa5,
+	0xe8, 0xaf, 0xa2, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x2a,
+	0x0d, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x0b,
+	0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0xbf, 0x01, 0x0a, 0x10,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64,
+	0x12, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x1a,
+	0x0f, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
+	0x3a, 0x01, 0x2a, 0x92, 0x41, 0x37, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x12, 0x0c, 0xe7, 0xa7, 0xbb, 0xe5, 0x8a, 0xa8, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x2a,
+	0x0f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0xd2, 0x01,
+	0x0a, 0x12, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x64, 0x12, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63,
 	0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x61, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x13, 0x2f, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x3a,
-	0x01, 0x2a, 0x92, 0x41, 0x40, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x12, 0x12, 0xe6, 0xa0, 0xa1, 0xe9, 0xaa, 0x8c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe4, 0xbf,
-	0xa1, 0xe6, 0x81, 0xaf, 0x2a, 0x12, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12,
-	0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0xd1, 0x01, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x42, 0x79, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2a, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x79, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x42, 0x79, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x63, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x73,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2f,
-	0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x3f, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x12, 0xe6, 0xb7, 0xbb, 0xe5, 0x8a, 0xa0, 0xe8, 0xae, 0xbe,
-	0xe5, 0xa4, 0x87, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x2a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x42, 0x79, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4a, 0x0b, 0x0a, 0x03,
-	0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x42, 0x49, 0x0a, 0x10, 0x61, 0x70, 0x69,
-	0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x50, 0x01, 0x5a,
-	0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x6b, 0x65, 0x65,
-	0x6c, 0x2d, 0x69, 0x6f, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2d, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x76,
-	0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x61, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x13, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x3a, 0x01, 0x2a, 0x92,
+	0x41, 0x40, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x12, 0xe6,
+	0xa0, 0xa1, 0xe9, 0xaa, 0x8c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe4, 0xbf, 0xa1, 0xe6, 0x81,
+	0xaf, 0x2a, 0x12, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x64, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02,
+	0x4f, 0x4b, 0x12, 0xd1, 0x01, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x42, 0x79, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x79, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x42, 0x79, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x63, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x3f, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x12, 0x12, 0xe6, 0xb7, 0xbb, 0xe5, 0x8a, 0xa0, 0xe8, 0xae, 0xbe, 0xe5, 0xa4, 0x87,
+	0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x2a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x42, 0x79, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30,
+	0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0x83, 0x02, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x2a, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x94, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x1a, 0x15,
+	0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x71, 0x0a, 0x09, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x44, 0xe8, 0xae, 0xbe, 0xe7, 0xbd, 0xae, 0xe8, 0xae,
+	0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe6, 0x9f, 0xa5,
+	0xe8, 0xaf, 0xa2, 0xe6, 0x9d, 0xa1, 0xe4, 0xbb, 0xb6, 0x2c, 0x20, 0xe8, 0xae, 0xa2, 0xe9, 0x98,
+	0x85, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe6, 0x8c, 0x89, 0xe6, 0x9d, 0xa1, 0xe4, 0xbb, 0xb6,
+	0xe5, 0xae, 0x9a, 0xe6, 0x9c, 0x9f, 0xe5, 0x90, 0x8c, 0xe6, 0xad, 0xa5, 0x2a, 0x11, 0x73, 0x65,
+	0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4a,
+	0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0xf2, 0x01, 0x0a,
+	0x15, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x2e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x78, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22,
+	0x18, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x2f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x52, 0x0a,
+	0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x21, 0xe9, 0xa2, 0x84, 0xe8,
+	0xa7, 0x88, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe6, 0x9d, 0xa1, 0xe4, 0xbb, 0xb6, 0xe5, 0x8c,
+	0xb9, 0xe9, 0x85, 0x8d, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x2a, 0x15, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f,
+	0x4b, 0x42, 0x49, 0x0a, 0x10, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x2e, 0x76, 0x31, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2d, 0x69, 0x6f, 0x2f, 0x63, 0x6f, 0x72,
+	0x65, 0x2d, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2413,75 +4024,115 @@ func file_api_subscribe_v1_subscribe_proto_rawDescGZIP() []byte {
 	return file_api_subscribe_v1_subscribe_proto_rawDescData
 }
 
-var file_api_subscribe_v1_subscribe_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_api_subscribe_v1_subscribe_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
 var file_api_subscribe_v1_subscribe_proto_goTypes = []interface{}{
-	(*SubscribeEntitiesByIDsRequest)(nil),     // 0: api.subscribe.v1.SubscribeEntitiesByIDsRequest
-	(*SubscribeEntitiesByIDsResponse)(nil),    // 1: api.subscribe.v1.SubscribeEntitiesByIDsResponse
-	(*SubscribeEntitiesByGroupsRequest)(nil),  // 2: api.subscribe.v1.SubscribeEntitiesByGroupsRequest
-	(*SubscribeEntitiesByGroupsResponse)(nil), // 3: api.subscribe.v1.SubscribeEntitiesByGroupsResponse
-	(*SubscribeEntitiesByModelsRequest)(nil),  // 4: api.subscribe.v1.SubscribeEntitiesByModelsRequest
-	(*SubscribeEntitiesByModelsResponse)(nil), // 5: api.subscribe.v1.SubscribeEntitiesByModelsResponse
-	(*UnsubscribeEntitiesByIDsRequest)(nil),   // 6: api.subscribe.v1.UnsubscribeEntitiesByIDsRequest
-	(*UnsubscribeEntitiesByIDsResponse)(nil),  // 7: api.subscribe.v1.UnsubscribeEntitiesByIDsResponse
-	(*DeleteEntitiesByIDRequest)(nil),         // 8: api.subscribe.v1.DeleteEntitiesByIDRequest
-	(*DeleteEntitiesByIDResponse)(nil),        // 9: api.subscribe.v1.DeleteEntitiesByIDResponse
-	(*ListSubscribeEntitiesRequest)(nil),      // 10: api.subscribe.v1.ListSubscribeEntitiesRequest
-	(*ListSubscribeEntitiesResponse)(nil),     // 11: api.subscribe.v1.ListSubscribeEntitiesResponse
-	(*SubscribeObject)(nil),                   // 12: api.subscribe.v1.SubscribeObject
-	(*CreateSubscribeRequest)(nil),            // 13: api.subscribe.v1.CreateSubscribeRequest
-	(*CreateSubscribeResponse)(nil),           // 14: api.subscribe.v1.CreateSubscribeResponse
-	(*UpdateSubscribeRequest)(nil),            // 15: api.subscribe.v1.UpdateSubscribeRequest
-	(*UpdateSubscribeResponse)(nil),           // 16: api.subscribe.v1.UpdateSubscribeResponse
-	(*DeleteSubscribeRequest)(nil),            // 17: api.subscribe.v1.DeleteSubscribeRequest
-	(*DeleteSubscribeResponse)(nil),           // 18: api.subscribe.v1.DeleteSubscribeResponse
-	(*GetSubscribeRequest)(nil),               // 19: api.subscribe.v1.GetSubscribeRequest
-	(*GetSubscribeResponse)(nil),              // 20: api.subscribe.v1.GetSubscribeResponse
-	(*ListSubscribeRequest)(nil),              // 21: api.subscribe.v1.ListSubscribeRequest
-	(*ListSubscribeResponse)(nil),             // 22: api.subscribe.v1.ListSubscribeResponse
-	(*ChangeSubscribedRequest)(nil),           // 23: api.subscribe.v1.ChangeSubscribedRequest
-	(*ChangeSubscribedResponse)(nil),          // 24: api.subscribe.v1.ChangeSubscribedResponse
-	(*Entity)(nil),                            // 25: api.subscribe.v1.Entity
-	(*ValidateSubscribedRequest)(nil),         // 26: api.subscribe.v1.ValidateSubscribedRequest
-	(*ValidateSubscribedResponse)(nil),        // 27: api.subscribe.v1.ValidateSubscribedResponse
-	(*SubscribeByDeviceRequest)(nil),          // 28: api.subscribe.v1.SubscribeByDeviceRequest
-	(*SubscribeByDeviceResponse)(nil),         // 29: api.subscribe.v1.SubscribeByDeviceResponse
+	(*SubscribeEntitiesByIDsRequest)(nil),       // 0: api.subscribe.v1.SubscribeEntitiesByIDsRequest
+	(*SubscribeEntitiesByIDsResponse)(nil),      // 1: api.subscribe.v1.SubscribeEntitiesByIDsResponse
+	(*SubscribeEntitiesByGroupsRequest)(nil),    // 2: api.subscribe.v1.SubscribeEntitiesByGroupsRequest
+	(*SubscribeEntitiesByGroupsResponse)(nil),   // 3: api.subscribe.v1.SubscribeEntitiesByGroupsResponse
+	(*SubscribeEntitiesByModelsRequest)(nil),    // 4: api.subscribe.v1.SubscribeEntitiesByModelsRequest
+	(*SubscribeEntitiesByModelsResponse)(nil),   // 5: api.subscribe.v1.SubscribeEntitiesByModelsResponse
+	(*UnsubscribeEntitiesByIDsRequest)(nil),     // 6: api.subscribe.v1.UnsubscribeEntitiesByIDsRequest
+	(*UnsubscribeEntitiesByIDsResponse)(nil),    // 7: api.subscribe.v1.UnsubscribeEntitiesByIDsResponse
+	(*UnsubscribeEntitiesByGroupsRequest)(nil),  // 8: api.subscribe.v1.UnsubscribeEntitiesByGroupsRequest
+	(*UnsubscribeEntitiesByGroupsResponse)(nil), // 9: api.subscribe.v1.UnsubscribeEntitiesByGroupsResponse
+	(*UnsubscribeEntitiesByModelsRequest)(nil),  // 10: api.subscribe.v1.UnsubscribeEntitiesByModelsRequest
+	(*UnsubscribeEntitiesByModelsResponse)(nil), // 11: api.subscribe.v1.UnsubscribeEntitiesByModelsResponse
+	(*EntityResult)(nil),                        // 12: api.subscribe.v1.EntityResult
+	(*DeleteEntitiesByIDRequest)(nil),           // 13: api.subscribe.v1.DeleteEntitiesByIDRequest
+	(*DeleteEntitiesByIDResponse)(nil),          // 14: api.subscribe.v1.DeleteEntitiesByIDResponse
+	(*ListSubscribeEntitiesRequest)(nil),        // 15: api.subscribe.v1.ListSubscribeEntitiesRequest
+	(*ListSubscribeEntitiesResponse)(nil),       // 16: api.subscribe.v1.ListSubscribeEntitiesResponse
+	(*SubscribeObject)(nil),                     // 17: api.subscribe.v1.SubscribeObject
+	(*AggregateOptions)(nil),                    // 18: api.subscribe.v1.AggregateOptions
+	(*DeadbandOptions)(nil),                     // 19: api.subscribe.v1.DeadbandOptions
+	(*CreateSubscribeRequest)(nil),              // 20: api.subscribe.v1.CreateSubscribeRequest
+	(*CreateSubscribeResponse)(nil),             // 21: api.subscribe.v1.CreateSubscribeResponse
+	(*UpdateSubscribeRequest)(nil),              // 22: api.subscribe.v1.UpdateSubscribeRequest
+	(*UpdateSubscribeResponse)(nil),             // 23: api.subscribe.v1.UpdateSubscribeResponse
+	(*DeleteSubscribeRequest)(nil),              // 24: api.subscribe.v1.DeleteSubscribeRequest
+	(*DeleteSubscribeResponse)(nil),             // 25: api.subscribe.v1.DeleteSubscribeResponse
+	(*GetSubscribeRequest)(nil),                 // 26: api.subscribe.v1.GetSubscribeRequest
+	(*GetSubscribeResponse)(nil),                // 27: api.subscribe.v1.GetSubscribeResponse
+	(*ListSubscribeRequest)(nil),                // 28: api.subscribe.v1.ListSubscribeRequest
+	(*ListSubscribeResponse)(nil),               // 29: api.subscribe.v1.ListSubscribeResponse
+	(*ChangeSubscribedRequest)(nil),             // 30: api.subscribe.v1.ChangeSubscribedRequest
+	(*ChangeSubscribedResponse)(nil),            // 31: api.subscribe.v1.ChangeSubscribedResponse
+	(*Entity)(nil),                              // 32: api.subscribe.v1.Entity
+	(*ValidateSubscribedRequest)(nil),           // 33: api.subscribe.v1.ValidateSubscribedRequest
+	(*ValidateSubscribedResponse)(nil),          // 34: api.subscribe.v1.ValidateSubscribedResponse
+	(*SubscribeByDeviceRequest)(nil),            // 35: api.subscribe.v1.SubscribeByDeviceRequest
+	(*SubscribeByDeviceResponse)(nil),           // 36: api.subscribe.v1.SubscribeByDeviceResponse
+	(*QueryCondition)(nil),                      // 37: api.subscribe.v1.QueryCondition
+	(*SetSubscribeQueryRequest)(nil),            // 38: api.subscribe.v1.SetSubscribeQueryRequest
+	(*SetSubscribeQueryResponse)(nil),           // 39: api.subscribe.v1.SetSubscribeQueryResponse
+	(*PreviewSubscribeQueryRequest)(nil),        // 40: api.subscribe.v1.PreviewSubscribeQueryRequest
+	(*PreviewSubscribeQueryResponse)(nil),       // 41: api.subscribe.v1.PreviewSubscribeQueryResponse
 }
 var file_api_subscribe_v1_subscribe_proto_depIdxs = []int32{
-	25, // 0: api.subscribe.v1.ListSubscribeEntitiesResponse.data:type_name -> api.subscribe.v1.Entity
-	12, // 1: api.subscribe.v1.ListSubscribeResponse.data:type_name -> api.subscribe.v1.SubscribeObject
-	0,  // 2: api.subscribe.v1.Subscribe.SubscribeEntitiesByIDs:input_type -> api.subscribe.v1.SubscribeEntitiesByIDsRequest
-	2,  // 3: api.subscribe.v1.Subscribe.SubscribeEntitiesByGroups:input_type -> api.subscribe.v1.SubscribeEntitiesByGroupsRequest
-	4,  // 4: api.subscribe.v1.Subscribe.SubscribeEntitiesByModels:input_type -> api.subscribe.v1.SubscribeEntitiesByModelsRequest
-	6,  // 5: api.subscribe.v1.Subscribe.UnsubscribeEntitiesByIDs:input_type -> api.subscribe.v1.UnsubscribeEntitiesByIDsRequest
-	8,  // 6: api.subscribe.v1.Subscribe.DeleteEntitiesByID:input_type -> api.subscribe.v1.DeleteEntitiesByIDRequest
-	10, // 7: api.subscribe.v1.Subscribe.ListSubscribeEntities:input_type -> api.subscribe.v1.ListSubscribeEntitiesRequest
-	13, // 8: api.subscribe.v1.Subscribe.CreateSubscribe:input_type -> api.subscribe.v1.CreateSubscribeRequest
-	15, // 9: api.subscribe.v1.Subscribe.UpdateSubscribe:input_type -> api.subscribe.v1.UpdateSubscribeRequest
-	17, // 10: api.subscribe.v1.Subscribe.DeleteSubscribe:input_type -> api.subscribe.v1.DeleteSubscribeRequest
-	19, // 11: api.subscribe.v1.Subscribe.GetSubscribe:input_type -> api.subscribe.v1.GetSubscribeRequest
-	21, // 12: api.subscribe.v1.Subscribe.ListSubscribe:input_type -> api.subscribe.v1.ListSubscribeRequest
-	23, // 13: api.subscribe.v1.Subscribe.ChangeSubscribed:input_type -> api.subscribe.v1.ChangeSubscribedRequest
-	26, // 14: api.subscribe.v1.Subscribe.ValidateSubscribed:input_type -> api.subscribe.v1.ValidateSubscribedRequest
-	28, // 15: api.subscribe.v1.Subscribe.SubscribeByDevice:input_type -> api.subscribe.v1.SubscribeByDeviceRequest
-	1,  // 16: api.subscribe.v1.Subscribe.SubscribeEntitiesByIDs:output_type -> api.subscribe.v1.SubscribeEntitiesByIDsResponse
-	3,  // 17: api.subscribe.v1.Subscribe.SubscribeEntitiesByGroups:output_type -> api.subscribe.v1.SubscribeEntitiesByGroupsResponse
-	5,  // 18: api.subscribe.v1.Subscribe.SubscribeEntitiesByModels:output_type -> api.subscribe.v1.SubscribeEntitiesByModelsResponse
-	7,  // 19: api.subscribe.v1.Subscribe.UnsubscribeEntitiesByIDs:output_type -> api.subscribe.v1.UnsubscribeEntitiesByIDsResponse
-	9,  // 20: api.subscribe.v1.Subscribe.DeleteEntitiesByID:output_type -> api.subscribe.v1.DeleteEntitiesByIDResponse
-	11, // 21: api.subscribe.v1.Subscribe.ListSubscribeEntities:output_type -> api.subscribe.v1.ListSubscribeEntitiesResponse
-	14, // 22: api.subscribe.v1.Subscribe.CreateSubscribe:output_type -> api.subscribe.v1.CreateSubscribeResponse
-	16, // 23: api.subscribe.v1.Subscribe.UpdateSubscribe:output_type -> api.subscribe.v1.UpdateSubscribeResponse
-	18, // 24: api.subscribe.v1.Subscribe.DeleteSubscribe:output_type -> api.subscribe.v1.DeleteSubscribeResponse
-	20, // 25: api.subscribe.v1.Subscribe.GetSubscribe:output_type -> api.subscribe.v1.GetSubscribeResponse
-	22, // 26: api.subscribe.v1.Subscribe.ListSubscribe:output_type -> api.subscribe.v1.ListSubscribeResponse
-	24, // 27: api.subscribe.v1.Subscribe.ChangeSubscribed:output_type -> api.subscribe.v1.ChangeSubscribedResponse
-	27, // 28: api.subscribe.v1.Subscribe.ValidateSubscribed:output_type -> api.subscribe.v1.ValidateSubscribedResponse
-	29, // 29: api.subscribe.v1.Subscribe.SubscribeByDevice:output_type -> api.subscribe.v1.SubscribeByDeviceResponse
-	16, // [16:30] is the sub-list for method output_type
-	2,  // [2:16] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	12, // 0: api.subscribe.v1.SubscribeEntitiesByIDsResponse.results:type_name -> api.subscribe.v1.EntityResult
+	12, // 1: api.subscribe.v1.SubscribeEntitiesByGroupsResponse.results:type_name -> api.subscribe.v1.EntityResult
+	12, // 2: api.subscribe.v1.SubscribeEntitiesByModelsResponse.results:type_name -> api.subscribe.v1.EntityResult
+	12, // 3: api.subscribe.v1.UnsubscribeEntitiesByGroupsResponse.results:type_name -> api.subscribe.v1.EntityResult
+	12, // 4: api.subscribe.v1.UnsubscribeEntitiesByModelsResponse.results:type_name -> api.subscribe.v1.EntityResult
+	32, // 5: api.subscribe.v1.ListSubscribeEntitiesResponse.data:type_name -> api.subscribe.v1.Entity
+	18, // 6: api.subscribe.v1.CreateSubscribeRequest.aggregate:type_name -> api.subscribe.v1.AggregateOptions
+	19, // 7: api.subscribe.v1.CreateSubscribeRequest.deadband:type_name -> api.subscribe.v1.DeadbandOptions
+	18, // 8: api.subscribe.v1.CreateSubscribeResponse.aggregate:type_name -> api.subscribe.v1.AggregateOptions
+	19, // 9: api.subscribe.v1.CreateSubscribeResponse.deadband:type_name -> api.subscribe.v1.DeadbandOptions
+	18, // 10: api.subscribe.v1.UpdateSubscribeRequest.aggregate:type_name -> api.subscribe.v1.AggregateOptions
+	19, // 11: api.subscribe.v1.UpdateSubscribeRequest.deadband:type_name -> api.subscribe.v1.DeadbandOptions
+	18, // 12: api.subscribe.v1.UpdateSubscribeResponse.aggregate:type_name -> api.subscribe.v1.AggregateOptions
+	19, // 13: api.subscribe.v1.UpdateSubscribeResponse.deadband:type_name -> api.subscribe.v1.DeadbandOptions
+	18, // 14: api.subscribe.v1.GetSubscribeResponse.aggregate:type_name -> api.subscribe.v1.AggregateOptions
+	19, // 15: api.subscribe.v1.GetSubscribeResponse.deadband:type_name -> api.subscribe.v1.DeadbandOptions
+	37, // 16: api.subscribe.v1.GetSubscribeResponse.query:type_name -> api.subscribe.v1.QueryCondition
+	17, // 17: api.subscribe.v1.ListSubscribeResponse.data:type_name -> api.subscribe.v1.SubscribeObject
+	12, // 18: api.subscribe.v1.ChangeSubscribedResponse.results:type_name -> api.subscribe.v1.EntityResult
+	37, // 19: api.subscribe.v1.SetSubscribeQueryRequest.conditions:type_name -> api.subscribe.v1.QueryCondition
+	37, // 20: api.subscribe.v1.PreviewSubscribeQueryRequest.conditions:type_name -> api.subscribe.v1.QueryCondition
+	32, // 21: api.subscribe.v1.PreviewSubscribeQueryResponse.data:type_name -> api.subscribe.v1.Entity
+	0,  // 22: api.subscribe.v1.Subscribe.SubscribeEntitiesByIDs:input_type -> api.subscribe.v1.SubscribeEntitiesByIDsRequest
+	2,  // 23: api.subscribe.v1.Subscribe.SubscribeEntitiesByGroups:input_type -> api.subscribe.v1.SubscribeEntitiesByGroupsRequest
+	4,  // 24: api.subscribe.v1.Subscribe.SubscribeEntitiesByModels:input_type -> api.subscribe.v1.SubscribeEntitiesByModelsRequest
+	6,  // 25: api.subscribe.v1.Subscribe.UnsubscribeEntitiesByIDs:input_type -> api.subscribe.v1.UnsubscribeEntitiesByIDsRequest
+	8,  // 26: api.subscribe.v1.Subscribe.UnsubscribeEntitiesByGroups:input_type -> api.subscribe.v1.UnsubscribeEntitiesByGroupsRequest
+	10, // 27: api.subscribe.v1.Subscribe.UnsubscribeEntitiesByModels:input_type -> api.subscribe.v1.UnsubscribeEntitiesByModelsRequest
+	13, // 28: api.subscribe.v1.Subscribe.DeleteEntitiesByID:input_type -> api.subscribe.v1.DeleteEntitiesByIDRequest
+	15, // 29: api.subscribe.v1.Subscribe.ListSubscribeEntities:input_type -> api.subscribe.v1.ListSubscribeEntitiesRequest
+	20, // 30: api.subscribe.v1.Subscribe.CreateSubscribe:input_type -> api.subscribe.v1.CreateSubscribeRequest
+	22, // 31: api.subscribe.v1.Subscribe.UpdateSubscribe:input_type -> api.subscribe.v1.UpdateSubscribeRequest
+	24, // 32: api.subscribe.v1.Subscribe.DeleteSubscribe:input_type -> api.subscribe.v1.DeleteSubscribeRequest
+	26, // 33: api.subscribe.v1.Subscribe.GetSubscribe:input_type -> api.subscribe.v1.GetSubscribeRequest
+	28, // 34: api.subscribe.v1.Subscribe.ListSubscribe:input_type -> api.subscribe.v1.ListSubscribeRequest
+	30, // 35: api.subscribe.v1.Subscribe.ChangeSubscribed:input_type -> api.subscribe.v1.ChangeSubscribedRequest
+	33, // 36: api.subscribe.v1.Subscribe.ValidateSubscribed:input_type -> api.subscribe.v1.ValidateSubscribedRequest
+	35, // 37: api.subscribe.v1.Subscribe.SubscribeByDevice:input_type -> api.subscribe.v1.SubscribeByDeviceRequest
+	38, // 38: api.subscribe.v1.Subscribe.SetSubscribeQuery:input_type -> api.subscribe.v1.SetSubscribeQueryRequest
+	40, // 39: api.subscribe.v1.Subscribe.PreviewSubscribeQuery:input_type -> api.subscribe.v1.PreviewSubscribeQueryRequest
+	1,  // 40: api.subscribe.v1.Subscribe.SubscribeEntitiesByIDs:output_type -> api.subscribe.v1.SubscribeEntitiesByIDsResponse
+	3,  // 41: api.subscribe.v1.Subscribe.SubscribeEntitiesByGroups:output_type -> api.subscribe.v1.SubscribeEntitiesByGroupsResponse
+	5,  // 42: api.subscribe.v1.Subscribe.SubscribeEntitiesByModels:output_type -> api.subscribe.v1.SubscribeEntitiesByModelsResponse
+	7,  // 43: api.subscribe.v1.Subscribe.UnsubscribeEntitiesByIDs:output_type -> api.subscribe.v1.UnsubscribeEntitiesByIDsResponse
+	9,  // 44: api.subscribe.v1.Subscribe.UnsubscribeEntitiesByGroups:output_type -> api.subscribe.v1.UnsubscribeEntitiesByGroupsResponse
+	11, // 45: api.subscribe.v1.Subscribe.UnsubscribeEntitiesByModels:output_type -> api.subscribe.v1.UnsubscribeEntitiesByModelsResponse
+	14, // 46: api.subscribe.v1.Subscribe.DeleteEntitiesByID:output_type -> api.subscribe.v1.DeleteEntitiesByIDResponse
+	16, // 47: api.subscribe.v1.Subscribe.ListSubscribeEntities:output_type -> api.subscribe.v1.ListSubscribeEntitiesResponse
+	21, // 48: api.subscribe.v1.Subscribe.CreateSubscribe:output_type -> api.subscribe.v1.CreateSubscribeResponse
+	23, // 49: api.subscribe.v1.Subscribe.UpdateSubscribe:output_type -> api.subscribe.v1.UpdateSubscribeResponse
+	25, // 50: api.subscribe.v1.Subscribe.DeleteSubscribe:output_type -> api.subscribe.v1.DeleteSubscribeResponse
+	27, // 51: api.subscribe.v1.Subscribe.GetSubscribe:output_type -> api.subscribe.v1.GetSubscribeResponse
+	29, // 52: api.subscribe.v1.Subscribe.ListSubscribe:output_type -> api.subscribe.v1.ListSubscribeResponse
+	31, // 53: api.subscribe.v1.Subscribe.ChangeSubscribed:output_type -> api.subscribe.v1.ChangeSubscribedResponse
+	34, // 54: api.subscribe.v1.Subscribe.ValidateSubscribed:output_type -> api.subscribe.v1.ValidateSubscribedResponse
+	36, // 55: api.subscribe.v1.Subscribe.SubscribeByDevice:output_type -> api.subscribe.v1.SubscribeByDeviceResponse
+	39, // 56: api.subscribe.v1.Subscribe.SetSubscribeQuery:output_type -> api.subscribe.v1.SetSubscribeQueryResponse
+	41, // 57: api.subscribe.v1.Subscribe.PreviewSubscribeQuery:output_type -> api.subscribe.v1.PreviewSubscribeQueryResponse
+	40, // [40:58] is the sub-list for method output_type
+	22, // [22:40] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_api_subscribe_v1_subscribe_proto_init() }
@@ -2587,7 +4238,7 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 			}
 		}
 		file_api_subscribe_v1_subscribe_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteEntitiesByIDRequest); i {
+			switch v := v.(*UnsubscribeEntitiesByGroupsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2599,7 +4250,7 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 			}
 		}
 		file_api_subscribe_v1_subscribe_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteEntitiesByIDResponse); i {
+			switch v := v.(*UnsubscribeEntitiesByGroupsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2611,7 +4262,7 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 			}
 		}
 		file_api_subscribe_v1_subscribe_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSubscribeEntitiesRequest); i {
+			switch v := v.(*UnsubscribeEntitiesByModelsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2623,7 +4274,7 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 			}
 		}
 		file_api_subscribe_v1_subscribe_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSubscribeEntitiesResponse); i {
+			switch v := v.(*UnsubscribeEntitiesByModelsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2635,7 +4286,7 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 			}
 		}
 		file_api_subscribe_v1_subscribe_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeObject); i {
+			switch v := v.(*EntityResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2647,7 +4298,7 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 			}
 		}
 		file_api_subscribe_v1_subscribe_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSubscribeRequest); i {
+			switch v := v.(*DeleteEntitiesByIDRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2659,7 +4310,7 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 			}
 		}
 		file_api_subscribe_v1_subscribe_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSubscribeResponse); i {
+			switch v := v.(*DeleteEntitiesByIDResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2671,7 +4322,7 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 			}
 		}
 		file_api_subscribe_v1_subscribe_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateSubscribeRequest); i {
+			switch v := v.(*ListSubscribeEntitiesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2683,7 +4334,7 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 			}
 		}
 		file_api_subscribe_v1_subscribe_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateSubscribeResponse); i {
+			switch v := v.(*ListSubscribeEntitiesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2695,7 +4346,7 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 			}
 		}
 		file_api_subscribe_v1_subscribe_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteSubscribeRequest); i {
+			switch v := v.(*SubscribeObject); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2707,7 +4358,7 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 			}
 		}
 		file_api_subscribe_v1_subscribe_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteSubscribeResponse); i {
+			switch v := v.(*AggregateOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2719,7 +4370,7 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 			}
 		}
 		file_api_subscribe_v1_subscribe_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSubscribeRequest); i {
+			switch v := v.(*DeadbandOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2731,7 +4382,7 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 			}
 		}
 		file_api_subscribe_v1_subscribe_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSubscribeResponse); i {
+			switch v := v.(*CreateSubscribeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2743,7 +4394,7 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 			}
 		}
 		file_api_subscribe_v1_subscribe_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSubscribeRequest); i {
+			switch v := v.(*CreateSubscribeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2755,7 +4406,7 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 			}
 		}
 		file_api_subscribe_v1_subscribe_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSubscribeResponse); i {
+			switch v := v.(*UpdateSubscribeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2767,7 +4418,7 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 			}
 		}
 		file_api_subscribe_v1_subscribe_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangeSubscribedRequest); i {
+			switch v := v.(*UpdateSubscribeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2779,7 +4430,7 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 			}
 		}
 		file_api_subscribe_v1_subscribe_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangeSubscribedResponse); i {
+			switch v := v.(*DeleteSubscribeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2791,7 +4442,7 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 			}
 		}
 		file_api_subscribe_v1_subscribe_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Entity); i {
+			switch v := v.(*DeleteSubscribeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2803,7 +4454,7 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 			}
 		}
 		file_api_subscribe_v1_subscribe_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidateSubscribedRequest); i {
+			switch v := v.(*GetSubscribeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2815,7 +4466,7 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 			}
 		}
 		file_api_subscribe_v1_subscribe_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidateSubscribedResponse); i {
+			switch v := v.(*GetSubscribeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2827,7 +4478,7 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 			}
 		}
 		file_api_subscribe_v1_subscribe_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeByDeviceRequest); i {
+			switch v := v.(*ListSubscribeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2839,6 +4490,90 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 			}
 		}
 		file_api_subscribe_v1_subscribe_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSubscribeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeSubscribedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeSubscribedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Entity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateSubscribedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateSubscribedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeByDeviceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubscribeByDeviceResponse); i {
 			case 0:
 				return &v.state
@@ -2850,6 +4585,66 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 				return nil
 			}
 		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSubscribeQueryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSubscribeQueryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewSubscribeQueryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewSubscribeQueryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2857,7 +4652,7 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_subscribe_v1_subscribe_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   30,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
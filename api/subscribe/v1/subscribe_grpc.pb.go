@@ -22,6 +22,8 @@ type SubscribeClient interface {
 	SubscribeEntitiesByGroups(ctx context.Context, in *SubscribeEntitiesByGroupsRequest, opts ...grpc.CallOption) (*SubscribeEntitiesByGroupsResponse, error)
 	SubscribeEntitiesByModels(ctx context.Context, in *SubscribeEntitiesByModelsRequest, opts ...grpc.CallOption) (*SubscribeEntitiesByModelsResponse, error)
 	UnsubscribeEntitiesByIDs(ctx context.Context, in *UnsubscribeEntitiesByIDsRequest, opts ...grpc.CallOption) (*UnsubscribeEntitiesByIDsResponse, error)
+	UnsubscribeEntitiesByGroups(ctx context.Context, in *UnsubscribeEntitiesByGroupsRequest, opts ...grpc.CallOption) (*UnsubscribeEntitiesByGroupsResponse, error)
+	UnsubscribeEntitiesByModels(ctx context.Context, in *UnsubscribeEntitiesByModelsRequest, opts ...grpc.CallOption) (*UnsubscribeEntitiesByModelsResponse, error)
 	DeleteEntitiesByID(ctx context.Context, in *DeleteEntitiesByIDRequest, opts ...grpc.CallOption) (*DeleteEntitiesByIDResponse, error)
 	ListSubscribeEntities(ctx context.Context, in *ListSubscribeEntitiesRequest, opts ...grpc.CallOption) (*ListSubscribeEntitiesResponse, error)
 	CreateSubscribe(ctx context.Context, in *CreateSubscribeRequest, opts ...grpc.CallOption) (*CreateSubscribeResponse, error)
@@ -32,6 +34,8 @@ type SubscribeClient interface {
 	ChangeSubscribed(ctx context.Context, in *ChangeSubscribedRequest, opts ...grpc.CallOption) (*ChangeSubscribedResponse, error)
 	ValidateSubscribed(ctx context.Context, in *ValidateSubscribedRequest, opts ...grpc.CallOption) (*ValidateSubscribedResponse, error)
 	SubscribeByDevice(ctx context.Context, in *SubscribeByDeviceRequest, opts ...grpc.CallOption) (*SubscribeByDeviceResponse, error)
+	SetSubscribeQuery(ctx context.Context, in *SetSubscribeQueryRequest, opts ...grpc.CallOption) (*SetSubscribeQueryResponse, error)
+	PreviewSubscribeQuery(ctx context.Context, in *PreviewSubscribeQueryRequest, opts ...grpc.CallOption) (*PreviewSubscribeQueryResponse, error)
 }
 
 type subscribeClient struct {
@@ -78,6 +82,24 @@ func (c *subscribeClient) UnsubscribeEntitiesByIDs(ctx context.Context, in *Unsu
 	return out, nil
 }
 
+func (c *subscribeClient) UnsubscribeEntitiesByGroups(ctx context.Context, in *UnsubscribeEntitiesByGroupsRequest, opts ...grpc.CallOption) (*UnsubscribeEntitiesByGroupsResponse, error) {
+	out := new(UnsubscribeEntitiesByGroupsResponse)
+	err := c.cc.Invoke(ctx, "/api.subscribe.v1.Subscribe/UnsubscribeEntitiesByGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscribeClient) UnsubscribeEntitiesByModels(ctx context.Context, in *UnsubscribeEntitiesByModelsRequest, opts ...grpc.CallOption) (*UnsubscribeEntitiesByModelsResponse, error) {
+	out := new(UnsubscribeEntitiesByModelsResponse)
+	err := c.cc.Invoke(ctx, "/api.subscribe.v1.Subscribe/UnsubscribeEntitiesByModels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *subscribeClient) DeleteEntitiesByID(ctx context.Context, in *DeleteEntitiesByIDRequest, opts ...grpc.CallOption) (*DeleteEntitiesByIDResponse, error) {
 	out := new(DeleteEntitiesByIDResponse)
 	err := c.cc.Invoke(ctx, "/api.subscribe.v1.Subscribe/DeleteEntitiesByID", in, out, opts...)
@@ -168,6 +190,24 @@ func (c *subscribeClient) SubscribeByDevice(ctx context.Context, in *SubscribeBy
 	return out, nil
 }
 
+func (c *subscribeClient) SetSubscribeQuery(ctx context.Context, in *SetSubscribeQueryRequest, opts ...grpc.CallOption) (*SetSubscribeQueryResponse, error) {
+	out := new(SetSubscribeQueryResponse)
+	err := c.cc.Invoke(ctx, "/api.subscribe.v1.Subscribe/SetSubscribeQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscribeClient) PreviewSubscribeQuery(ctx context.Context, in *PreviewSubscribeQueryRequest, opts ...grpc.CallOption) (*PreviewSubscribeQueryResponse, error) {
+	out := new(PreviewSubscribeQueryResponse)
+	err := c.cc.Invoke(ctx, "/api.subscribe.v1.Subscribe/PreviewSubscribeQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SubscribeServer is the server API for Subscribe service.
 // All implementations must embed UnimplementedSubscribeServer
 // for forward compatibility
@@ -176,6 +216,8 @@ type SubscribeServer interface {
 	SubscribeEntitiesByGroups(context.Context, *SubscribeEntitiesByGroupsRequest) (*SubscribeEntitiesByGroupsResponse, error)
 	SubscribeEntitiesByModels(context.Context, *SubscribeEntitiesByModelsRequest) (*SubscribeEntitiesByModelsResponse, error)
 	UnsubscribeEntitiesByIDs(context.Context, *UnsubscribeEntitiesByIDsRequest) (*UnsubscribeEntitiesByIDsResponse, error)
+	UnsubscribeEntitiesByGroups(context.Context, *UnsubscribeEntitiesByGroupsRequest) (*UnsubscribeEntitiesByGroupsResponse, error)
+	UnsubscribeEntitiesByModels(context.Context, *UnsubscribeEntitiesByModelsRequest) (*UnsubscribeEntitiesByModelsResponse, error)
 	DeleteEntitiesByID(context.Context, *DeleteEntitiesByIDRequest) (*DeleteEntitiesByIDResponse, error)
 	ListSubscribeEntities(context.Context, *ListSubscribeEntitiesRequest) (*ListSubscribeEntitiesResponse, error)
 	CreateSubscribe(context.Context, *CreateSubscribeRequest) (*CreateSubscribeResponse, error)
@@ -186,6 +228,8 @@ type SubscribeServer interface {
 	ChangeSubscribed(context.Context, *ChangeSubscribedRequest) (*ChangeSubscribedResponse, error)
 	ValidateSubscribed(context.Context, *ValidateSubscribedRequest) (*ValidateSubscribedResponse, error)
 	SubscribeByDevice(context.Context, *SubscribeByDeviceRequest) (*SubscribeByDeviceResponse, error)
+	SetSubscribeQuery(context.Context, *SetSubscribeQueryRequest) (*SetSubscribeQueryResponse, error)
+	PreviewSubscribeQuery(context.Context, *PreviewSubscribeQueryRequest) (*PreviewSubscribeQueryResponse, error)
 	mustEmbedUnimplementedSubscribeServer()
 }
 
@@ -205,6 +249,12 @@ func (UnimplementedSubscribeServer) SubscribeEntitiesByModels(context.Context, *
 func (UnimplementedSubscribeServer) UnsubscribeEntitiesByIDs(context.Context, *UnsubscribeEntitiesByIDsRequest) (*UnsubscribeEntitiesByIDsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeEntitiesByIDs not implemented")
 }
+func (UnimplementedSubscribeServer) UnsubscribeEntitiesByGroups(context.Context, *UnsubscribeEntitiesByGroupsRequest) (*UnsubscribeEntitiesByGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeEntitiesByGroups not implemented")
+}
+func (UnimplementedSubscribeServer) UnsubscribeEntitiesByModels(context.Context, *UnsubscribeEntitiesByModelsRequest) (*UnsubscribeEntitiesByModelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeEntitiesByModels not implemented")
+}
 func (UnimplementedSubscribeServer) DeleteEntitiesByID(context.Context, *DeleteEntitiesByIDRequest) (*DeleteEntitiesByIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteEntitiesByID not implemented")
 }
@@ -235,6 +285,12 @@ func (UnimplementedSubscribeServer) ValidateSubscribed(context.Context, *Validat
 func (UnimplementedSubscribeServer) SubscribeByDevice(context.Context, *SubscribeByDeviceRequest) (*SubscribeByDeviceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubscribeByDevice not implemented")
 }
+func (UnimplementedSubscribeServer) SetSubscribeQuery(context.Context, *SetSubscribeQueryRequest) (*SetSubscribeQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSubscribeQuery not implemented")
+}
+func (UnimplementedSubscribeServer) PreviewSubscribeQuery(context.Context, *PreviewSubscribeQueryRequest) (*PreviewSubscribeQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewSubscribeQuery not implemented")
+}
 func (UnimplementedSubscribeServer) mustEmbedUnimplementedSubscribeServer() {}
 
 // UnsafeSubscribeServer may be embedded to opt out of forward compatibility for this service.
@@ -320,6 +376,42 @@ func _Subscribe_UnsubscribeEntitiesByIDs_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Subscribe_UnsubscribeEntitiesByGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeEntitiesByGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscribeServer).UnsubscribeEntitiesByGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.subscribe.v1.Subscribe/UnsubscribeEntitiesByGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscribeServer).UnsubscribeEntitiesByGroups(ctx, req.(*UnsubscribeEntitiesByGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscribe_UnsubscribeEntitiesByModels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeEntitiesByModelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscribeServer).UnsubscribeEntitiesByModels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.subscribe.v1.Subscribe/UnsubscribeEntitiesByModels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscribeServer).UnsubscribeEntitiesByModels(ctx, req.(*UnsubscribeEntitiesByModelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Subscribe_DeleteEntitiesByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteEntitiesByIDRequest)
 	if err := dec(in); err != nil {
@@ -500,6 +592,42 @@ func _Subscribe_SubscribeByDevice_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Subscribe_SetSubscribeQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSubscribeQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscribeServer).SetSubscribeQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.subscribe.v1.Subscribe/SetSubscribeQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscribeServer).SetSubscribeQuery(ctx, req.(*SetSubscribeQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscribe_PreviewSubscribeQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewSubscribeQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscribeServer).PreviewSubscribeQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.subscribe.v1.Subscribe/PreviewSubscribeQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscribeServer).PreviewSubscribeQuery(ctx, req.(*PreviewSubscribeQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Subscribe_ServiceDesc is the grpc.ServiceDesc for Subscribe service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -523,6 +651,14 @@ var Subscribe_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnsubscribeEntitiesByIDs",
 			Handler:    _Subscribe_UnsubscribeEntitiesByIDs_Handler,
 		},
+		{
+			MethodName: "UnsubscribeEntitiesByGroups",
+			Handler:    _Subscribe_UnsubscribeEntitiesByGroups_Handler,
+		},
+		{
+			MethodName: "UnsubscribeEntitiesByModels",
+			Handler:    _Subscribe_UnsubscribeEntitiesByModels_Handler,
+		},
 		{
 			MethodName: "DeleteEntitiesByID",
 			Handler:    _Subscribe_DeleteEntitiesByID_Handler,
@@ -563,6 +699,14 @@ var Subscribe_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubscribeByDevice",
 			Handler:    _Subscribe_SubscribeByDevice_Handler,
 		},
+		{
+			MethodName: "SetSubscribeQuery",
+			Handler:    _Subscribe_SetSubscribeQuery_Handler,
+		},
+		{
+			MethodName: "PreviewSubscribeQuery",
+			Handler:    _Subscribe_PreviewSubscribeQuery_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/subscribe/v1/subscribe.proto",
@@ -35,11 +35,15 @@ type SubscribeHTTPServer interface {
 	GetSubscribe(context.Context, *GetSubscribeRequest) (*GetSubscribeResponse, error)
 	ListSubscribe(context.Context, *ListSubscribeRequest) (*ListSubscribeResponse, error)
 	ListSubscribeEntities(context.Context, *ListSubscribeEntitiesRequest) (*ListSubscribeEntitiesResponse, error)
+	PreviewSubscribeQuery(context.Context, *PreviewSubscribeQueryRequest) (*PreviewSubscribeQueryResponse, error)
+	SetSubscribeQuery(context.Context, *SetSubscribeQueryRequest) (*SetSubscribeQueryResponse, error)
 	SubscribeByDevice(context.Context, *SubscribeByDeviceRequest) (*SubscribeByDeviceResponse, error)
 	SubscribeEntitiesByGroups(context.Context, *SubscribeEntitiesByGroupsRequest) (*SubscribeEntitiesByGroupsResponse, error)
 	SubscribeEntitiesByIDs(context.Context, *SubscribeEntitiesByIDsRequest) (*SubscribeEntitiesByIDsResponse, error)
 	SubscribeEntitiesByModels(context.Context, *SubscribeEntitiesByModelsRequest) (*SubscribeEntitiesByModelsResponse, error)
+	UnsubscribeEntitiesByGroups(context.Context, *UnsubscribeEntitiesByGroupsRequest) (*UnsubscribeEntitiesByGroupsResponse, error)
 	UnsubscribeEntitiesByIDs(context.Context, *UnsubscribeEntitiesByIDsRequest) (*UnsubscribeEntitiesByIDsResponse, error)
+	UnsubscribeEntitiesByModels(context.Context, *UnsubscribeEntitiesByModelsRequest) (*UnsubscribeEntitiesByModelsResponse, error)
 	UpdateSubscribe(context.Context, *UpdateSubscribeRequest) (*UpdateSubscribeResponse, error)
 	ValidateSubscribed(context.Context, *ValidateSubscribedRequest) (*ValidateSubscribedResponse, error)
 }
@@ -469,6 +473,123 @@ func (h *SubscribeHTTPHandler) ListSubscribeEntities(req *go_restful.Request, re
 	}
 }
 
+func (h *SubscribeHTTPHandler) PreviewSubscribeQuery(req *go_restful.Request, resp *go_restful.Response) {
+	in := PreviewSubscribeQueryRequest{}
+	if err := transportHTTP.GetBody(req, &in); err != nil {
+		resp.WriteHeaderAndJson(http.StatusBadRequest,
+			result.Set(errors.InternalError.Reason, err.Error(), nil), "application/json")
+		return
+	}
+
+	ctx := transportHTTP.ContextWithHeader(req.Request.Context(), req.Request.Header)
+
+	out, err := h.srv.PreviewSubscribeQuery(ctx, &in)
+	if err != nil {
+		tErr := errors.FromError(err)
+		httpCode := errors.GRPCToHTTPStatusCode(tErr.GRPCStatus().Code())
+		if httpCode == http.StatusMovedPermanently {
+			resp.Header().Set("Location", tErr.Message)
+		}
+		resp.WriteHeaderAndJson(httpCode,
+			result.Set(tErr.Reason, tErr.Message, out), "application/json")
+		return
+	}
+	anyOut, err := anypb.New(out)
+	if err != nil {
+		resp.WriteHeaderAndJson(http.StatusInternalServerError,
+			result.Set(errors.InternalError.Reason, err.Error(), nil), "application/json")
+		return
+	}
+
+	outB, err := protojson.MarshalOptions{
+		UseProtoNames:   true,
+		EmitUnpopulated: true,
+	}.Marshal(&result.Http{
+		Code: errors.Success.Reason,
+		Msg:  "",
+		Data: anyOut,
+	})
+	if err != nil {
+		resp.WriteHeaderAndJson(http.StatusInternalServerError,
+			result.Set(errors.InternalError.Reason, err.Error(), nil), "application/json")
+		return
+	}
+	resp.AddHeader(go_restful.HEADER_ContentType, "application/json")
+
+	var remain int
+	for {
+		outB = outB[remain:]
+		remain, err = resp.Write(outB)
+		if err != nil {
+			return
+		}
+		if remain == 0 {
+			break
+		}
+	}
+}
+
+func (h *SubscribeHTTPHandler) SetSubscribeQuery(req *go_restful.Request, resp *go_restful.Response) {
+	in := SetSubscribeQueryRequest{}
+	if err := transportHTTP.GetBody(req, &in); err != nil {
+		resp.WriteHeaderAndJson(http.StatusBadRequest,
+			result.Set(errors.InternalError.Reason, err.Error(), nil), "application/json")
+		return
+	}
+	if err := transportHTTP.GetPathValue(req, &in); err != nil {
+		resp.WriteHeaderAndJson(http.StatusBadRequest,
+			result.Set(errors.InternalError.Reason, err.Error(), nil), "application/json")
+		return
+	}
+
+	ctx := transportHTTP.ContextWithHeader(req.Request.Context(), req.Request.Header)
+
+	out, err := h.srv.SetSubscribeQuery(ctx, &in)
+	if err != nil {
+		tErr := errors.FromError(err)
+		httpCode := errors.GRPCToHTTPStatusCode(tErr.GRPCStatus().Code())
+		if httpCode == http.StatusMovedPermanently {
+			resp.Header().Set("Location", tErr.Message)
+		}
+		resp.WriteHeaderAndJson(httpCode,
+			result.Set(tErr.Reason, tErr.Message, out), "application/json")
+		return
+	}
+	anyOut, err := anypb.New(out)
+	if err != nil {
+		resp.WriteHeaderAndJson(http.StatusInternalServerError,
+			result.Set(errors.InternalError.Reason, err.Error(), nil), "application/json")
+		return
+	}
+
+	outB, err := protojson.MarshalOptions{
+		UseProtoNames:   true,
+		EmitUnpopulated: true,
+	}.Marshal(&result.Http{
+		Code: errors.Success.Reason,
+		Msg:  "",
+		Data: anyOut,
+	})
+	if err != nil {
+		resp.WriteHeaderAndJson(http.StatusInternalServerError,
+			result.Set(errors.InternalError.Reason, err.Error(), nil), "application/json")
+		return
+	}
+	resp.AddHeader(go_restful.HEADER_ContentType, "application/json")
+
+	var remain int
+	for {
+		outB = outB[remain:]
+		remain, err = resp.Write(outB)
+		if err != nil {
+			return
+		}
+		if remain == 0 {
+			break
+		}
+	}
+}
+
 func (h *SubscribeHTTPHandler) SubscribeByDevice(req *go_restful.Request, resp *go_restful.Response) {
 	in := SubscribeByDeviceRequest{}
 	if err := transportHTTP.GetBody(req, &in); err != nil {
@@ -713,6 +834,67 @@ func (h *SubscribeHTTPHandler) SubscribeEntitiesByModels(req *go_restful.Request
 	}
 }
 
+func (h *SubscribeHTTPHandler) UnsubscribeEntitiesByGroups(req *go_restful.Request, resp *go_restful.Response) {
+	in := UnsubscribeEntitiesByGroupsRequest{}
+	if err := transportHTTP.GetBody(req, &in); err != nil {
+		resp.WriteHeaderAndJson(http.StatusBadRequest,
+			result.Set(errors.InternalError.Reason, err.Error(), nil), "application/json")
+		return
+	}
+	if err := transportHTTP.GetPathValue(req, &in); err != nil {
+		resp.WriteHeaderAndJson(http.StatusBadRequest,
+			result.Set(errors.InternalError.Reason, err.Error(), nil), "application/json")
+		return
+	}
+
+	ctx := transportHTTP.ContextWithHeader(req.Request.Context(), req.Request.Header)
+
+	out, err := h.srv.UnsubscribeEntitiesByGroups(ctx, &in)
+	if err != nil {
+		tErr := errors.FromError(err)
+		httpCode := errors.GRPCToHTTPStatusCode(tErr.GRPCStatus().Code())
+		if httpCode == http.StatusMovedPermanently {
+			resp.Header().Set("Location", tErr.Message)
+		}
+		resp.WriteHeaderAndJson(httpCode,
+			result.Set(tErr.Reason, tErr.Message, out), "application/json")
+		return
+	}
+	anyOut, err := anypb.New(out)
+	if err != nil {
+		resp.WriteHeaderAndJson(http.StatusInternalServerError,
+			result.Set(errors.InternalError.Reason, err.Error(), nil), "application/json")
+		return
+	}
+
+	outB, err := protojson.MarshalOptions{
+		UseProtoNames:   true,
+		EmitUnpopulated: true,
+	}.Marshal(&result.Http{
+		Code: errors.Success.Reason,
+		Msg:  "",
+		Data: anyOut,
+	})
+	if err != nil {
+		resp.WriteHeaderAndJson(http.StatusInternalServerError,
+			result.Set(errors.InternalError.Reason, err.Error(), nil), "application/json")
+		return
+	}
+	resp.AddHeader(go_restful.HEADER_ContentType, "application/json")
+
+	var remain int
+	for {
+		outB = outB[remain:]
+		remain, err = resp.Write(outB)
+		if err != nil {
+			return
+		}
+		if remain == 0 {
+			break
+		}
+	}
+}
+
 func (h *SubscribeHTTPHandler) UnsubscribeEntitiesByIDs(req *go_restful.Request, resp *go_restful.Response) {
 	in := UnsubscribeEntitiesByIDsRequest{}
 	if err := transportHTTP.GetBody(req, &in); err != nil {
@@ -774,6 +956,67 @@ func (h *SubscribeHTTPHandler) UnsubscribeEntitiesByIDs(req *go_restful.Request,
 	}
 }
 
+func (h *SubscribeHTTPHandler) UnsubscribeEntitiesByModels(req *go_restful.Request, resp *go_restful.Response) {
+	in := UnsubscribeEntitiesByModelsRequest{}
+	if err := transportHTTP.GetBody(req, &in); err != nil {
+		resp.WriteHeaderAndJson(http.StatusBadRequest,
+			result.Set(errors.InternalError.Reason, err.Error(), nil), "application/json")
+		return
+	}
+	if err := transportHTTP.GetPathValue(req, &in); err != nil {
+		resp.WriteHeaderAndJson(http.StatusBadRequest,
+			result.Set(errors.InternalError.Reason, err.Error(), nil), "application/json")
+		return
+	}
+
+	ctx := transportHTTP.ContextWithHeader(req.Request.Context(), req.Request.Header)
+
+	out, err := h.srv.UnsubscribeEntitiesByModels(ctx, &in)
+	if err != nil {
+		tErr := errors.FromError(err)
+		httpCode := errors.GRPCToHTTPStatusCode(tErr.GRPCStatus().Code())
+		if httpCode == http.StatusMovedPermanently {
+			resp.Header().Set("Location", tErr.Message)
+		}
+		resp.WriteHeaderAndJson(httpCode,
+			result.Set(tErr.Reason, tErr.Message, out), "application/json")
+		return
+	}
+	anyOut, err := anypb.New(out)
+	if err != nil {
+		resp.WriteHeaderAndJson(http.StatusInternalServerError,
+			result.Set(errors.InternalError.Reason, err.Error(), nil), "application/json")
+		return
+	}
+
+	outB, err := protojson.MarshalOptions{
+		UseProtoNames:   true,
+		EmitUnpopulated: true,
+	}.Marshal(&result.Http{
+		Code: errors.Success.Reason,
+		Msg:  "",
+		Data: anyOut,
+	})
+	if err != nil {
+		resp.WriteHeaderAndJson(http.StatusInternalServerError,
+			result.Set(errors.InternalError.Reason, err.Error(), nil), "application/json")
+		return
+	}
+	resp.AddHeader(go_restful.HEADER_ContentType, "application/json")
+
+	var remain int
+	for {
+		outB = outB[remain:]
+		remain, err = resp.Write(outB)
+		if err != nil {
+			return
+		}
+		if remain == 0 {
+			break
+		}
+	}
+}
+
 func (h *SubscribeHTTPHandler) UpdateSubscribe(req *go_restful.Request, resp *go_restful.Response) {
 	in := UpdateSubscribeRequest{}
 	if err := transportHTTP.GetBody(req, &in); err != nil {
@@ -915,6 +1158,10 @@ func RegisterSubscribeHTTPServer(container *go_restful.Container, srv SubscribeH
 		To(handler.SubscribeEntitiesByModels))
 	ws.Route(ws.POST("/subscribe/{id}/entities/delete").
 		To(handler.UnsubscribeEntitiesByIDs))
+	ws.Route(ws.POST("/subscribe/{id}/groups/delete").
+		To(handler.UnsubscribeEntitiesByGroups))
+	ws.Route(ws.POST("/subscribe/{id}/models/delete").
+		To(handler.UnsubscribeEntitiesByModels))
 	ws.Route(ws.DELETE("/entities/{id}").
 		To(handler.DeleteEntitiesByID))
 	ws.Route(ws.POST("/subscribe/{id}/entities/list").
@@ -935,4 +1182,8 @@ func RegisterSubscribeHTTPServer(container *go_restful.Container, srv SubscribeH
 		To(handler.ValidateSubscribed))
 	ws.Route(ws.POST("/subscribe/device/{id}").
 		To(handler.SubscribeByDevice))
+	ws.Route(ws.PUT("/subscribe/{id}/query").
+		To(handler.SetSubscribeQuery))
+	ws.Route(ws.POST("/subscribe/query/preview").
+		To(handler.PreviewSubscribeQuery))
 }
@@ -0,0 +1,53 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"flag"
+
+	"github.com/tkeel-io/core-broker/pkg/config"
+	"github.com/tkeel-io/core-broker/pkg/deviceutil"
+	"github.com/tkeel-io/kit/log"
+)
+
+// loadConfig loads the config file and overrides it by the flags set on the
+// command line.
+func loadConfig() (*config.Config, error) {
+	c, err := config.Load(ConfigPath)
+	if err != nil {
+		return nil, err
+	}
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "name":
+			c.Name = Name
+		case "http_addr":
+			c.HTTPAddr = HTTPAddr
+		case "grpc_addr":
+			c.GRPCAddr = GRPCAddr
+		}
+	})
+	return c, c.Validate()
+}
+
+// reloadConfig applies the settings which are safe to change at runtime.
+func reloadConfig(c *config.Config, entities *deviceutil.EntityCache) {
+	if err := log.InitLoggerByConf(&log.Conf{App: c.Name, Level: c.Log.Level, Dev: c.Log.Dev}); err != nil {
+		log.Error("reload logger err:", err)
+	}
+	entities.Configure(c.DeviceCache.Size, c.DeviceCache.TTL)
+}
@@ -19,15 +19,23 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	"github.com/tkeel-io/core-broker/pkg/auth"
+	"github.com/tkeel-io/core-broker/pkg/config"
+	"github.com/tkeel-io/core-broker/pkg/core"
+	"github.com/tkeel-io/core-broker/pkg/delivery"
+	"github.com/tkeel-io/core-broker/pkg/deviceutil"
 	"github.com/tkeel-io/core-broker/pkg/metrics"
 	"github.com/tkeel-io/core-broker/pkg/model"
+	"github.com/tkeel-io/core-broker/pkg/schedule"
 	"github.com/tkeel-io/core-broker/pkg/server"
 	"github.com/tkeel-io/core-broker/pkg/service"
+	"github.com/tkeel-io/core-broker/pkg/types"
 	"github.com/tkeel-io/kit/app"
 	"github.com/tkeel-io/kit/log"
 	"github.com/tkeel-io/kit/transport"
@@ -50,48 +58,104 @@ var (
 	HTTPAddr string
 	// GRPCAddr string.
 	GRPCAddr string
+	// ConfigPath string.
+	ConfigPath string
 )
 
 func init() {
-	flag.StringVar(&Name, "name", "core-broker", "app name.")
-	flag.StringVar(&HTTPAddr, "http_addr", ":31234", "http listen address.")
-	flag.StringVar(&GRPCAddr, "grpc_addr", ":31233", "grpc listen address.")
+	defaults := config.Default()
+	flag.StringVar(&Name, "name", defaults.Name, "app name.")
+	flag.StringVar(&HTTPAddr, "http_addr", defaults.HTTPAddr, "http listen address.")
+	flag.StringVar(&GRPCAddr, "grpc_addr", defaults.GRPCAddr, "grpc listen address.")
+	flag.StringVar(&ConfigPath, "config", os.Getenv("CORE_BROKER_CONFIG"), "config file path.")
 }
 
 func main() {
 	flag.Parse()
 
-	httpSrv := server.NewHTTPServer(HTTPAddr)
-	grpcSrv := server.NewGRPCServer(GRPCAddr)
+	conf, err := loadConfig()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "invalid config:", err)
+		os.Exit(1)
+	}
+	coreClient := core.NewResilientClient(core.NewCoreClient(conf.Dapr), conf.Core)
+	modelOptions := model.Options{
+		Database:   conf.Database,
+		AMQPServer: conf.AMQPServer,
+		Core:       coreClient,
+	}
+
+	if flag.Arg(0) == "migrate" {
+		if err := migrate(flag.Args()[1:], modelOptions); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	httpSrv := server.NewHTTPServer(conf.HTTPAddr)
+	grpcSrv := server.NewGRPCServer(conf.GRPCAddr)
 	serverList := []transport.Server{httpSrv, grpcSrv}
 
-	app := app.New(Name,
+	app := app.New(conf.Name,
 		&log.Conf{
-			App:   Name,
-			Level: "debug",
-			Dev:   true,
+			App:   conf.Name,
+			Level: conf.Log.Level,
+			Dev:   conf.Log.Dev,
 		},
 		serverList...,
 	)
 
+	if err := model.Setup(modelOptions); err != nil {
+		log.Fatal(err)
+	}
+	entities := deviceutil.NewEntityCache(conf.DeviceCache.Size, conf.DeviceCache.TTL)
+	processor := delivery.NewProcessor(delivery.NewDaprPublisher(conf.Dapr.PubsubName), entities)
+	go processor.Run()
+	scheduler := schedule.NewScheduler()
+	go scheduler.Run()
+	var queryEvaluator *service.QueryEvaluator
+	var entitySrv *service.EntityService
+	var wsRegistry *service.WsRegistry
+	var configWatcher *config.Watcher
+	if conf.HotReload && ConfigPath != "" {
+		configWatcher = config.NewWatcher(ConfigPath, func(c *config.Config) {
+			reloadConfig(c, entities)
+		})
+		go configWatcher.Run()
+	}
+
 	{ // User service
 		OpenapiSrv := service.NewOpenapiService()
 		openapi.RegisterOpenapiHTTPServer(httpSrv.Container, OpenapiSrv)
 		openapi.RegisterOpenapiServer(grpcSrv.GetServe(), OpenapiSrv)
 
-		EntitySrv := service.NewEntityService()
-		go EntitySrv.Run()
-		Entity_v1.RegisterEntityHTTPServer(httpSrv.Container, EntitySrv)
+		authenticator := auth.NewSecurityAuthenticator(conf.Dapr.InvokeURL(conf.Dapr.KeelAppID, "apis/security/v1/oauth/authenticate"))
+		wsRegistry = service.NewWsRegistry(types.Topic, coreClient, conf.WebSocket.RegistryLease)
+		if err := wsRegistry.Start(); err != nil {
+			log.Error("start ws registry err:", err)
+		}
+		go wsRegistry.Run()
+		entitySrv = service.NewEntityService(coreClient, authenticator, wsRegistry, conf.WebSocket)
+		go entitySrv.Run()
+		Entity_v1.RegisterEntityHTTPServer(httpSrv.Container, entitySrv)
 
-		TopicSrv := service.NewTopicService()
+		TopicSrv := service.NewTopicService(processor, entities, conf.Dapr.EntityDeletedTopic)
 		Topic_v1.RegisterTopicHTTPServer(httpSrv.Container, TopicSrv)
 		Topic_v1.RegisterTopicServer(grpcSrv.GetServe(), TopicSrv)
 
-		DaprSubscribeSrv := service.NewDaprSubscribeService()
+		DaprSubscribeSrv := service.NewDaprSubscribeService(conf.Dapr)
 		Dapr_v1.RegisterSubscribeHTTPServer(httpSrv.Container, DaprSubscribeSrv)
 		Dapr_v1.RegisterSubscribeServer(grpcSrv.GetServe(), DaprSubscribeSrv)
 
-		SubscribeSrv := service.NewSubscribeService()
+		SubscribeSrv := service.NewSubscribeService(
+			model.NewRepository(model.DB()),
+			deviceutil.NewServices(conf.Dapr),
+			entities,
+			conf.BulkSubscribe,
+		)
+		queryEvaluator = service.NewQueryEvaluator(SubscribeSrv)
+		go queryEvaluator.Run()
 		Subscribe_v1.RegisterSubscribeHTTPServer(httpSrv.Container, SubscribeSrv)
 		Subscribe_v1.RegisterSubscribeServer(grpcSrv.GetServe(), SubscribeSrv)
 
@@ -117,7 +181,20 @@ func main() {
 	signal.Notify(stop, syscall.SIGTERM, os.Interrupt)
 	<-stop
 
+	// The WebSocket connections are hijacked, stopping the server leaves them.
+	ctx, cancel := context.WithTimeout(context.Background(), conf.WebSocket.DrainTimeout+conf.WebSocket.WriteTimeout)
+	if err := entitySrv.Shutdown(ctx); err != nil {
+		log.Error("shutdown websocket err:", err)
+	}
+	cancel()
+	wsRegistry.Stop()
 	if err := app.Stop(context.TODO()); err != nil {
 		panic(err)
 	}
+	if configWatcher != nil {
+		configWatcher.Stop()
+	}
+	queryEvaluator.Stop()
+	scheduler.Stop()
+	processor.Stop()
 }
@@ -0,0 +1,96 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core-broker/pkg/model"
+)
+
+const migrateUsage = `usage: core-broker migrate <command>
+
+commands:
+  status          list the migrations and whether they are applied
+  up [version]    apply the pending migrations up to version, all by default
+  down [steps]    revert the last applied migrations, one by default`
+
+// migrate runs the migrate sub-command against the database of the options,
+// whose core client the migrations of the _subscribeAddr entries invoke.
+func migrate(args []string, opts model.Options) error {
+	if len(args) == 0 || (args[0] != "status" && args[0] != "up" && args[0] != "down") {
+		return errors.New(migrateUsage)
+	}
+	if err := model.SetupConnection(opts); err != nil {
+		return err
+	}
+	switch args[0] {
+	case "status":
+		return migrateStatus()
+	case "up":
+		target, err := migrateArg(args, 0)
+		if err != nil {
+			return err
+		}
+		return model.MigrateUp(uint(target))
+	case "down":
+		steps, err := migrateArg(args, 1)
+		if err != nil {
+			return err
+		}
+		return model.MigrateDown(steps)
+	}
+	return nil
+}
+
+func migrateArg(args []string, defaultValue int) (int, error) {
+	if len(args) < 2 {
+		return defaultValue, nil
+	}
+	v, err := strconv.Atoi(args[1])
+	if err != nil || v < 0 {
+		return 0, errors.Errorf("invalid argument %q\n%s", args[1], migrateUsage)
+	}
+	return v, nil
+}
+
+func migrateStatus() error {
+	version, err := model.SchemaVersion()
+	if err != nil {
+		return err
+	}
+	states, err := model.MigrationStatus()
+	if err != nil {
+		return err
+	}
+	fmt.Printf("schema version: %d, broker version: %d\n", version, model.LatestSchemaVersion())
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+	for _, state := range states {
+		appliedAt := "pending"
+		if state.AppliedAt != nil {
+			appliedAt = state.AppliedAt.Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\n", state.Version, state.Name, appliedAt)
+	}
+	return w.Flush()
+}
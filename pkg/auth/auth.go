@@ -3,6 +3,7 @@ package auth
 import (
 	"context"
 	"encoding/base64"
+	"net/http"
 	"net/url"
 	"strings"
 
@@ -26,14 +27,39 @@ type User struct {
 }
 
 func GetUser(ctx context.Context) (User, error) {
-	u := User{}
 	headers := tkeelTransutil.HeaderFromContext(ctx)
 	authHTTPHeader, ok := headers[_XtKeelAuthUserHeader]
 	if !ok {
-		return u, ErrNotFound
+		return User{}, ErrNotFound
 	}
-	authInfo := strings.Join(authHTTPHeader, "")
-	u.Auth = authInfo
+	u, err := ParseAuth(strings.Join(authHTTPHeader, ""))
+	if err != nil {
+		return u, err
+	}
+	token, ok := headers[_AuthorizationHeader]
+	if ok {
+		u.Token = strings.Join(token, "")
+	}
+	return u, nil
+}
+
+// GetRequestUser is GetUser for a plain HTTP request.
+func GetRequestUser(req *http.Request) (User, error) {
+	authInfo := req.Header.Get(_XtKeelAuthUserHeader)
+	if authInfo == "" {
+		return User{}, ErrNotFound
+	}
+	u, err := ParseAuth(authInfo)
+	if err != nil {
+		return u, err
+	}
+	u.Token = req.Header.Get(_AuthorizationHeader)
+	return u, nil
+}
+
+// ParseAuth parses the X-Tkeel-Auth header set by the tKeel gateway.
+func ParseAuth(authInfo string) (User, error) {
+	u := User{Auth: authInfo}
 	authStrBytes, err := base64.StdEncoding.DecodeString(authInfo)
 	if err != nil {
 		err = errors.Wrap(err, "decode auth header error")
@@ -47,9 +73,14 @@ func GetUser(ctx context.Context) (User, error) {
 	u.ID = q.Get("user")
 	u.Role = q.Get("role")
 	u.TenantID = q.Get("tenant")
-	token, ok := headers[_AuthorizationHeader]
-	if ok {
-		u.Token = strings.Join(token, "")
-	}
 	return u, nil
 }
+
+// EncodeAuth is the X-Tkeel-Auth header of the user.
+func EncodeAuth(u User) string {
+	q := url.Values{}
+	q.Set("user", u.ID)
+	q.Set("role", u.Role)
+	q.Set("tenant", u.TenantID)
+	return base64.StdEncoding.EncodeToString([]byte(q.Encode()))
+}
@@ -0,0 +1,87 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ErrInvalidToken means the access token is expired or unknown.
+var ErrInvalidToken = errors.New("invalid token")
+
+// TokenAuthenticator resolves the access token of a request which did not
+// pass the tKeel gateway, like a WebSocket opened by a browser.
+type TokenAuthenticator interface {
+	Authenticate(ctx context.Context, token string) (User, error)
+}
+
+// AuthenticatorFunc is a TokenAuthenticator function.
+type AuthenticatorFunc func(ctx context.Context, token string) (User, error)
+
+func (f AuthenticatorFunc) Authenticate(ctx context.Context, token string) (User, error) {
+	return f(ctx, token)
+}
+
+type authenticateResponse struct {
+	Code string `json:"code"`
+	Msg  string `json:"msg"`
+	Data struct {
+		UserID   string   `json:"user_id"`
+		TenantID string   `json:"tenant_id"`
+		Roles    []string `json:"roles"`
+	} `json:"data"`
+}
+
+type securityAuthenticator struct {
+	url  string
+	http *http.Client
+}
+
+// NewSecurityAuthenticator resolves tokens with the oauth authenticate API of
+// the tKeel security service at url.
+func NewSecurityAuthenticator(url string) TokenAuthenticator {
+	return &securityAuthenticator{url: url, http: &http.Client{}}
+}
+
+func (a *securityAuthenticator) Authenticate(ctx context.Context, token string) (User, error) {
+	if !strings.HasPrefix(token, "Bearer ") {
+		token = "Bearer " + token
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, a.url, nil)
+	if err != nil {
+		return User{}, err
+	}
+	req.Header.Set(_AuthorizationHeader, token)
+	resp, err := a.http.Do(req)
+	if err != nil {
+		return User{}, errors.Wrap(err, "invoke authenticate error")
+	}
+	defer resp.Body.Close()
+	content, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return User{}, errors.Wrap(err, "read authenticate response error")
+	}
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return User{}, ErrInvalidToken
+	}
+	if resp.StatusCode != http.StatusOK {
+		return User{}, errors.Errorf("authenticate response status %d: %s", resp.StatusCode, content)
+	}
+	out := authenticateResponse{}
+	if err = json.Unmarshal(content, &out); err != nil {
+		return User{}, errors.Wrap(err, "decode authenticate response error")
+	}
+	if out.Data.UserID == "" {
+		return User{}, errors.Wrapf(ErrInvalidToken, "authenticate response %s: %s", out.Code, out.Msg)
+	}
+	u := User{ID: out.Data.UserID, TenantID: out.Data.TenantID, Token: token}
+	if len(out.Data.Roles) != 0 {
+		u.Role = out.Data.Roles[0]
+	}
+	u.Auth = EncodeAuth(u)
+	return u, nil
+}
@@ -0,0 +1,44 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeAuth(t *testing.T) {
+	u := User{ID: "usr-1", Role: "admin", TenantID: "tenant-1"}
+	parsed, err := ParseAuth(EncodeAuth(u))
+	assert.Nil(t, err)
+	u.Auth = EncodeAuth(u)
+	assert.Equal(t, u, parsed)
+}
+
+func TestSecurityAuthenticator(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{"code":"io.tkeel.SUCCESS","data":{"user_id":"usr-1","tenant_id":"tenant-1","roles":["admin"]}}`))
+	}))
+	defer server.Close()
+	authenticator := NewSecurityAuthenticator(server.URL)
+
+	u, err := authenticator.Authenticate(context.Background(), "token-1")
+	assert.Nil(t, err)
+	assert.Equal(t, "usr-1", u.ID)
+	assert.Equal(t, "tenant-1", u.TenantID)
+	assert.Equal(t, "admin", u.Role)
+	assert.Equal(t, "Bearer token-1", u.Token)
+	parsed, err := ParseAuth(u.Auth)
+	assert.Nil(t, err)
+	assert.Equal(t, u.ID, parsed.ID)
+
+	_, err = authenticator.Authenticate(context.Background(), "token-2")
+	assert.True(t, errors.Is(err, ErrInvalidToken))
+}
@@ -0,0 +1,334 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package config loads the settings of the broker from a YAML file, the
+// environment variables override the file and the command line flags
+// override both.
+package config
+
+import (
+	"io/ioutil"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// environment variables overriding the file.
+const (
+	EnvDSN            = "DSN"
+	EnvDBDriver       = "DB_DRIVER"
+	EnvAMQPServer     = "AMQP_SERVER"
+	EnvLogLevel       = "LOG_LEVEL"
+	EnvDaprHTTPPort   = "DAPR_HTTP_PORT"
+	EnvPubsubName     = "PUBSUB_NAME"
+	EnvCoreAppID      = "CORE_APP_ID"
+	EnvKeelAppID      = "KEEL_APP_ID"
+	EnvDeviceCacheTTL = "DEVICE_CACHE_TTL"
+)
+
+type Config struct {
+	Name     string `yaml:"name"`
+	HTTPAddr string `yaml:"http_addr"`
+	GRPCAddr string `yaml:"grpc_addr"`
+	// AMQPServer is the address of the AMQP endpoints of the subscribes.
+	AMQPServer  string      `yaml:"amqp_server"`
+	Log         Log         `yaml:"log"`
+	Database    Database    `yaml:"database"`
+	Dapr        Dapr        `yaml:"dapr"`
+	DeviceCache DeviceCache `yaml:"device_cache"`
+	Core        Core        `yaml:"core"`
+	// BulkSubscribe tunes adding many entities to a subscribe at once.
+	BulkSubscribe BulkSubscribe `yaml:"bulk_subscribe"`
+	WebSocket     WebSocket     `yaml:"websocket"`
+	// HotReload makes the broker watch the file and apply the changes of
+	// the fields which are safe to change at runtime: log.level and
+	// device_cache.
+	HotReload bool `yaml:"hot_reload"`
+}
+
+type Log struct {
+	Level string `yaml:"level"`
+	Dev   bool   `yaml:"dev"`
+}
+
+type Database struct {
+	// Driver is one of mysql, postgres and sqlite.
+	Driver string `yaml:"driver"`
+	// DSN like "user:pass@tcp(127.0.0.1:3306)/dbname?charset=utf8mb4&parseTime=True&loc=Local"
+	DSN string `yaml:"dsn"`
+}
+
+type Dapr struct {
+	// HTTPEndpoint is the address of the HTTP API of the dapr sidecar.
+	HTTPEndpoint string `yaml:"http_endpoint"`
+	PubsubName   string `yaml:"pubsub_name"`
+	CoreAppID    string `yaml:"core_app_id"`
+	KeelAppID    string `yaml:"keel_app_id"`
+	// EntityDeletedTopic is the topic of the pubsub the IDs of the entities
+	// deleted in the platform are published to, the broker does not consume
+	// one if it is empty.
+	EntityDeletedTopic string `yaml:"entity_deleted_topic"`
+}
+
+// InvokeURL is the URL the method of the app is invoked at through dapr.
+func (d Dapr) InvokeURL(appID, method string) string {
+	return strings.TrimSuffix(d.HTTPEndpoint, "/") + "/v1.0/invoke/" + appID + "/method/" + strings.TrimPrefix(method, "/")
+}
+
+// DeviceCache configures the cache of the device metadata. The entries are
+// invalidated by the changes the broker receives from core, the TTL bounds
+// how stale the others get.
+type DeviceCache struct {
+	Size int           `yaml:"size"`
+	TTL  time.Duration `yaml:"ttl"`
+}
+
+// WebSocket configures the entity WebSocket endpoint.
+type WebSocket struct {
+	// AllowedOrigins are the origins browsers may connect from, "*" allows
+	// any. The origin of the broker itself is always allowed.
+	AllowedOrigins []string `yaml:"allowed_origins"`
+	// QueueSize bounds the messages waiting to be written to a connection,
+	// OverflowPolicy applies to a full queue.
+	QueueSize      int    `yaml:"queue_size"`
+	OverflowPolicy string `yaml:"overflow_policy"`
+	// PingInterval is how often the connections are pinged, a connection
+	// which sends nothing, pongs included, for IdleTimeout is closed.
+	PingInterval time.Duration `yaml:"ping_interval"`
+	IdleTimeout  time.Duration `yaml:"idle_timeout"`
+	// WriteTimeout bounds writing a message to a connection.
+	WriteTimeout time.Duration `yaml:"write_timeout"`
+	// DrainTimeout bounds writing out the queued messages at shutdown.
+	DrainTimeout time.Duration `yaml:"drain_timeout"`
+	// RegistryLease is how long the core subscriptions of an instance
+	// outlive it, the instance renews its lease three times per lease.
+	RegistryLease time.Duration `yaml:"registry_lease"`
+	// UnsubscribeGrace delays unsubscribing an entity in core after its
+	// last client left, so that a client coming back, like a refreshed
+	// browser, does not subscribe it again. Zero unsubscribes right away.
+	UnsubscribeGrace time.Duration `yaml:"unsubscribe_grace"`
+}
+
+// overflow policies of the WebSocket queues.
+const (
+	// OverflowDropOldest drops the oldest queued message.
+	OverflowDropOldest = "drop-oldest"
+	// OverflowDropNewest drops the message being queued.
+	OverflowDropNewest = "drop-newest"
+	// OverflowDisconnect closes the connection.
+	OverflowDisconnect = "disconnect"
+)
+
+// Core configures the invocations of core.
+type Core struct {
+	// Timeout bounds every attempt of an invocation.
+	Timeout time.Duration `yaml:"timeout"`
+	// Retries is the number of retries of the idempotent invocations, the
+	// backoff between them doubles from RetryBackoff up to RetryMaxBackoff
+	// and is jittered.
+	Retries         int           `yaml:"retries"`
+	RetryBackoff    time.Duration `yaml:"retry_backoff"`
+	RetryMaxBackoff time.Duration `yaml:"retry_max_backoff"`
+	// BreakerThreshold consecutive failures open the circuit breaker, which
+	// fails the invocations fast for BreakerCooldown before letting one
+	// through to probe core. Zero disables the breaker.
+	BreakerThreshold int           `yaml:"breaker_threshold"`
+	BreakerCooldown  time.Duration `yaml:"breaker_cooldown"`
+}
+
+type BulkSubscribe struct {
+	// BatchSize is the number of memberships inserted per statement.
+	BatchSize int `yaml:"batch_size"`
+	// Concurrency is the number of entities whose core subscription and
+	// _subscribeAddr are set up at the same time.
+	Concurrency int `yaml:"concurrency"`
+}
+
+func Default() *Config {
+	return &Config{
+		Name:       "core-broker",
+		HTTPAddr:   ":31234",
+		GRPCAddr:   ":31233",
+		AMQPServer: "amqp://localhost:3172",
+		Log:        Log{Level: "info"},
+		Database:   Database{Driver: "mysql"},
+		Dapr: Dapr{
+			HTTPEndpoint: "http://localhost:3500",
+			PubsubName:   "core-broker-pubsub",
+			CoreAppID:    "core",
+			KeelAppID:    "keel",
+		},
+		DeviceCache: DeviceCache{Size: 10000, TTL: 5 * time.Minute},
+		Core: Core{
+			Timeout:          5 * time.Second,
+			Retries:          2,
+			RetryBackoff:     100 * time.Millisecond,
+			RetryMaxBackoff:  time.Second,
+			BreakerThreshold: 5,
+			BreakerCooldown:  10 * time.Second,
+		},
+		BulkSubscribe: BulkSubscribe{BatchSize: 500, Concurrency: 16},
+		WebSocket: WebSocket{
+			QueueSize:      256,
+			OverflowPolicy: OverflowDropOldest,
+			PingInterval:   30 * time.Second,
+			IdleTimeout:    75 * time.Second,
+			WriteTimeout:   10 * time.Second,
+			DrainTimeout:   5 * time.Second,
+			RegistryLease:  30 * time.Second,
+			// A refreshed dashboard reconnects within seconds.
+			UnsubscribeGrace: 10 * time.Second,
+		},
+	}
+}
+
+// Load reads the defaults overridden by the file, if path is not empty, and
+// by the environment variables.
+func Load(path string) (*Config, error) {
+	c := Default()
+	if path != "" {
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrap(err, "read config file")
+		}
+		if err = yaml.Unmarshal(content, c); err != nil {
+			return nil, errors.Wrapf(err, "parse config file %s", path)
+		}
+	}
+	if err := c.overrideByEnv(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *Config) overrideByEnv() error {
+	overrides := map[string]*string{
+		EnvDSN:        &c.Database.DSN,
+		EnvDBDriver:   &c.Database.Driver,
+		EnvAMQPServer: &c.AMQPServer,
+		EnvLogLevel:   &c.Log.Level,
+		EnvPubsubName: &c.Dapr.PubsubName,
+		EnvCoreAppID:  &c.Dapr.CoreAppID,
+		EnvKeelAppID:  &c.Dapr.KeelAppID,
+	}
+	for env, field := range overrides {
+		if v := os.Getenv(env); v != "" {
+			*field = v
+		}
+	}
+	if v := os.Getenv(EnvDaprHTTPPort); v != "" {
+		if _, err := strconv.ParseUint(v, 10, 16); err != nil {
+			return errors.Errorf("invalid %s %q", EnvDaprHTTPPort, v)
+		}
+		c.Dapr.HTTPEndpoint = "http://localhost:" + v
+	}
+	if v := os.Getenv(EnvDeviceCacheTTL); v != "" {
+		ttl, err := time.ParseDuration(v)
+		if err != nil {
+			return errors.Wrapf(err, "invalid %s", EnvDeviceCacheTTL)
+		}
+		c.DeviceCache.TTL = ttl
+	}
+	return nil
+}
+
+var (
+	drivers          = []string{"mysql", "postgres", "sqlite"}
+	logLevels        = []string{"debug", "info", "warn", "error", "dpanic", "panic", "fatal"}
+	overflowPolicies = []string{OverflowDropOldest, OverflowDropNewest, OverflowDisconnect}
+)
+
+// Validate reports the first invalid setting.
+func (c *Config) Validate() error {
+	switch {
+	case c.Name == "":
+		return errors.New("name is empty")
+	case c.HTTPAddr == "":
+		return errors.New("http_addr is empty")
+	case c.GRPCAddr == "":
+		return errors.New("grpc_addr is empty")
+	case !contains(logLevels, c.Log.Level):
+		return errors.Errorf("log.level %q is not one of %s", c.Log.Level, strings.Join(logLevels, ", "))
+	case !contains(drivers, c.Database.Driver):
+		return errors.Errorf("database.driver %q is not one of %s", c.Database.Driver, strings.Join(drivers, ", "))
+	case c.Database.DSN == "":
+		return errors.New("database.dsn is empty")
+	case c.Dapr.PubsubName == "":
+		return errors.New("dapr.pubsub_name is empty")
+	case c.Dapr.CoreAppID == "":
+		return errors.New("dapr.core_app_id is empty")
+	case c.Dapr.KeelAppID == "":
+		return errors.New("dapr.keel_app_id is empty")
+	case c.DeviceCache.Size <= 0:
+		return errors.New("device_cache.size must be positive")
+	case c.DeviceCache.TTL <= 0:
+		return errors.New("device_cache.ttl must be positive")
+	case c.Core.Timeout <= 0:
+		return errors.New("core.timeout must be positive")
+	case c.Core.Retries < 0:
+		return errors.New("core.retries must not be negative")
+	case c.Core.RetryBackoff <= 0 || c.Core.RetryMaxBackoff < c.Core.RetryBackoff:
+		return errors.New("core.retry_backoff must be positive and not above core.retry_max_backoff")
+	case c.Core.BreakerThreshold < 0:
+		return errors.New("core.breaker_threshold must not be negative")
+	case c.Core.BreakerCooldown <= 0:
+		return errors.New("core.breaker_cooldown must be positive")
+	case c.BulkSubscribe.BatchSize <= 0:
+		return errors.New("bulk_subscribe.batch_size must be positive")
+	case c.BulkSubscribe.Concurrency <= 0:
+		return errors.New("bulk_subscribe.concurrency must be positive")
+	case c.WebSocket.QueueSize <= 0:
+		return errors.New("websocket.queue_size must be positive")
+	case !contains(overflowPolicies, c.WebSocket.OverflowPolicy):
+		return errors.Errorf("websocket.overflow_policy %q is not one of %s", c.WebSocket.OverflowPolicy, strings.Join(overflowPolicies, ", "))
+	case c.WebSocket.PingInterval <= 0 || c.WebSocket.IdleTimeout <= c.WebSocket.PingInterval:
+		return errors.New("websocket.ping_interval must be positive and below websocket.idle_timeout")
+	case c.WebSocket.WriteTimeout <= 0:
+		return errors.New("websocket.write_timeout must be positive")
+	case c.WebSocket.DrainTimeout <= 0:
+		return errors.New("websocket.drain_timeout must be positive")
+	case c.WebSocket.RegistryLease <= 0:
+		return errors.New("websocket.registry_lease must be positive")
+	case c.WebSocket.UnsubscribeGrace < 0:
+		return errors.New("websocket.unsubscribe_grace must not be negative")
+	}
+	if err := validateURL("amqp_server", c.AMQPServer); err != nil {
+		return err
+	}
+	return validateURL("dapr.http_endpoint", c.Dapr.HTTPEndpoint)
+}
+
+func validateURL(name, value string) error {
+	u, err := url.Parse(value)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return errors.Errorf("%s %q is not a valid URL", name, value)
+	}
+	return nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+package config
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "core-broker.yaml")
+	assert.Nil(t, ioutil.WriteFile(path, []byte(`
+log:
+  level: debug
+database:
+  driver: sqlite
+  dsn: /tmp/core-broker.db
+device_cache:
+  ttl: 1m
+`), 0o600))
+	t.Setenv(EnvDSN, "")
+	t.Setenv(EnvLogLevel, "warn")
+	t.Setenv(EnvDaprHTTPPort, "3501")
+
+	c, err := Load(path)
+	assert.Nil(t, err)
+	assert.Equal(t, "warn", c.Log.Level)
+	assert.Equal(t, Database{Driver: "sqlite", DSN: "/tmp/core-broker.db"}, c.Database)
+	assert.Equal(t, DeviceCache{Size: 10000, TTL: time.Minute}, c.DeviceCache)
+	assert.Equal(t, "http://localhost:3501/v1.0/invoke/keel/method/apis/security", c.Dapr.InvokeURL(c.Dapr.KeelAppID, "/apis/security"))
+	assert.Nil(t, c.Validate())
+
+	t.Setenv(EnvDaprHTTPPort, "sidecar")
+	_, err = Load(path)
+	assert.NotNil(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	c := Default()
+	assert.EqualError(t, c.Validate(), "database.dsn is empty")
+
+	c.Database.DSN = "core-broker.db"
+	c.Database.Driver = "oracle"
+	assert.NotNil(t, c.Validate())
+
+	c.Database.Driver = "sqlite"
+	c.AMQPServer = "localhost"
+	assert.NotNil(t, c.Validate())
+
+	c.AMQPServer = "amqp://localhost:3172"
+	assert.Nil(t, c.Validate())
+
+	c.WebSocket.OverflowPolicy = "block"
+	assert.NotNil(t, c.Validate())
+
+	c.WebSocket.OverflowPolicy = OverflowDisconnect
+	c.WebSocket.IdleTimeout = c.WebSocket.PingInterval
+	assert.NotNil(t, c.Validate())
+
+	c.WebSocket.IdleTimeout = 2 * c.WebSocket.PingInterval
+	c.WebSocket.UnsubscribeGrace = -time.Second
+	assert.NotNil(t, c.Validate())
+
+	c.WebSocket.UnsubscribeGrace = 0
+	c.Core.RetryMaxBackoff = c.Core.RetryBackoff / 2
+	assert.NotNil(t, c.Validate())
+}
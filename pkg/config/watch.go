@@ -0,0 +1,75 @@
+package config
+
+import (
+	"os"
+	"time"
+
+	"github.com/tkeel-io/kit/log"
+)
+
+const watchInterval = 10 * time.Second
+
+// Watcher reloads the config file whenever it is modified and hands the
+// valid configs to onChange.
+type Watcher struct {
+	path     string
+	modTime  time.Time
+	onChange func(*Config)
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+func NewWatcher(path string, onChange func(*Config)) *Watcher {
+	w := &Watcher{
+		path:     path,
+		onChange: onChange,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	if info, err := os.Stat(path); err == nil {
+		w.modTime = info.ModTime()
+	}
+	return w
+}
+
+// Run watches the file until Stop is called.
+func (w *Watcher) Run() {
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.stop:
+			close(w.done)
+			return
+		case <-ticker.C:
+			w.check()
+		}
+	}
+}
+
+func (w *Watcher) Stop() {
+	close(w.stop)
+	<-w.done
+}
+
+func (w *Watcher) check() {
+	info, err := os.Stat(w.path)
+	if err != nil {
+		log.Error("stat config file err:", err)
+		return
+	}
+	if !info.ModTime().After(w.modTime) {
+		return
+	}
+	w.modTime = info.ModTime()
+	c, err := Load(w.path)
+	if err == nil {
+		err = c.Validate()
+	}
+	if err != nil {
+		log.Error("reload config err:", err)
+		return
+	}
+	log.Infof("config file %s reloaded", w.path)
+	w.onChange(c)
+}
@@ -0,0 +1,136 @@
+package core
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrCircuitOpen is returned without invoking core while the circuit breaker
+// is open.
+var ErrCircuitOpen = errors.New("core circuit breaker is open")
+
+type BreakerState int
+
+const (
+	BreakerClosed BreakerState = iota
+	BreakerHalfOpen
+	BreakerOpen
+)
+
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerHalfOpen:
+		return "half-open"
+	case BreakerOpen:
+		return "open"
+	default:
+		return "closed"
+	}
+}
+
+// Breaker opens after threshold consecutive failures and rejects the calls
+// until cooldown has passed, then lets one call through: its success closes
+// the breaker and its failure opens it again. A threshold of zero disables
+// the breaker.
+type Breaker struct {
+	lock      sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	state     BreakerState
+	failures  int
+	openedAt  time.Time
+	probing   bool
+	now       func() time.Time
+	onChange  func(BreakerState)
+}
+
+func NewBreaker(threshold int, cooldown time.Duration, onChange func(BreakerState)) *Breaker {
+	return &Breaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+		onChange:  onChange,
+	}
+}
+
+// Allow reports ErrCircuitOpen if the call must not be made, otherwise the
+// result of the call must be passed to Done.
+func (b *Breaker) Allow() error {
+	if b.threshold <= 0 {
+		return nil
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	switch b.state {
+	case BreakerOpen:
+		if b.now().Sub(b.openedAt) < b.cooldown {
+			return ErrCircuitOpen
+		}
+		b.setState(BreakerHalfOpen)
+		b.probing = true
+		return nil
+	case BreakerHalfOpen:
+		if b.probing {
+			return ErrCircuitOpen
+		}
+		b.probing = true
+	}
+	return nil
+}
+
+// Done records the result of an allowed call.
+func (b *Breaker) Done(success bool) {
+	if b.threshold <= 0 {
+		return
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.state == BreakerHalfOpen {
+		b.probing = false
+		if success {
+			b.failures = 0
+			b.setState(BreakerClosed)
+		} else {
+			b.open()
+		}
+		return
+	}
+	if success {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.state == BreakerClosed && b.failures >= b.threshold {
+		b.open()
+	}
+}
+
+// Release ends an allowed call without recording its result.
+func (b *Breaker) Release() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.probing = false
+}
+
+func (b *Breaker) State() BreakerState {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.state
+}
+
+func (b *Breaker) open() {
+	b.openedAt = b.now()
+	b.setState(BreakerOpen)
+}
+
+func (b *Breaker) setState(state BreakerState) {
+	if b.state == state {
+		return
+	}
+	b.state = state
+	if b.onChange != nil {
+		b.onChange(state)
+	}
+}
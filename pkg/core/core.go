@@ -5,24 +5,50 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sync"
 
-	"github.com/tkeel-io/core-broker/pkg/types"
+	"github.com/tkeel-io/core-broker/pkg/config"
 	"github.com/tkeel-io/kit/log"
 
 	dapr "github.com/dapr/go-sdk/client"
 	"github.com/pkg/errors"
 )
 
-type Client struct {
+// Client invokes the APIs of core.
+type Client interface {
+	Subscribe(ctx context.Context, subscriptionID, entityID, topic string, userID string, fields ...string) error
+	Unsubscribe(ctx context.Context, subscriptionID, userID string) error
+	PatchEntity(ctx context.Context, entityID string, data []map[string]interface{}) error
+	GetDeviceEntity(ctx context.Context, entityID string) (*Entity, error)
+	CreateEntity(ctx context.Context, id, userID, source string) (*Entity, error)
+}
+
+var _ Client = (*DaprClient)(nil)
+
+// DaprClient invokes core through the dapr sidecar.
+type DaprClient struct {
+	appID      string
+	pubsubName string
+	once       sync.Once
 	daprClient dapr.Client
+	err        error
 }
 
-func NewCoreClient() (*Client, error) {
-	client, err := dapr.NewClient()
-	if err != nil {
-		return nil, errors.Wrap(err, "init dapr client error")
+// NewCoreClient returns a client invoking the core app of the dapr settings
+// whose dapr client is created on first use, core publishes the events of
+// the subscriptions to the pubsub of the broker.
+func NewCoreClient(conf config.Dapr) *DaprClient {
+	return &DaprClient{appID: conf.CoreAppID, pubsubName: conf.PubsubName}
+}
+
+func (c *DaprClient) client() (dapr.Client, error) {
+	c.once.Do(func() {
+		c.daprClient, c.err = dapr.NewClient()
+	})
+	if c.err != nil {
+		return nil, errors.Wrap(c.err, "init dapr client error")
 	}
-	return &Client{daprClient: client}, nil
+	return c.daprClient, nil
 }
 
 type SubscriptionData struct {
@@ -33,24 +59,25 @@ type SubscriptionData struct {
 	PubsubName string `json:"pubsub_name,omitempty"`
 }
 
-func (c *Client) Subscribe(subscriptionID, entityID, topic string, userID string, fields ...string) error {
+func (c *DaprClient) Subscribe(ctx context.Context, subscriptionID, entityID, topic string, userID string, fields ...string) error {
 	if subscriptionID == "" ||
 		entityID == "" ||
 		topic == "" {
 		return errors.New("subscriptionID, entityID or topic is empty")
 	}
-	ctx := context.Background()
 	field := "*"
 	if len(fields) == 1 {
 		field = fields[0]
 	}
 	filter := IntoFilterQuery(subscriptionID, entityID, field)
+	// Core always delivers in realtime, the other subscribe modes are processed
+	// by the broker (see model.Subscribe.Processed).
 	subscriptionRequestData := SubscriptionData{
 		Mode:       "realtime",
 		Source:     "ignore",
 		Filter:     filter,
 		Topic:      topic,
-		PubsubName: types.PubsubName,
+		PubsubName: c.pubsubName,
 	}
 
 	methodName := CreateSubscriptionURL(subscriptionID, userID, "dm", "SUBSCRIPTION")
@@ -68,7 +95,11 @@ func (c *Client) Subscribe(subscriptionID, entityID, topic string, userID string
 		ContentType: MimeJson,
 	}
 
-	if c, err := c.daprClient.InvokeMethodWithContent(ctx, AppID, methodName, http.MethodPost, content); err != nil {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	if c, err := client.InvokeMethodWithContent(ctx, c.appID, methodName, http.MethodPost, content); err != nil {
 		log.Error("invoke ", methodName, err)
 		log.Error("invoke Response:", string(c))
 		return errors.Wrap(err, "invoke method error")
@@ -76,11 +107,14 @@ func (c *Client) Subscribe(subscriptionID, entityID, topic string, userID string
 	return nil
 }
 
-func (c *Client) Unsubscribe(subscriptionID, userID string) error {
-	ctx := context.Background()
+func (c *DaprClient) Unsubscribe(ctx context.Context, subscriptionID, userID string) error {
 	methodName := CreateUnsubscriptionURL(subscriptionID, userID, "dm", "SUBSCRIPTION")
 	log.Debug("invoke unsubscribe to Core: ", methodName)
-	if c, err := c.daprClient.InvokeMethod(ctx, AppID, methodName, http.MethodDelete); err != nil {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	if c, err := client.InvokeMethod(ctx, c.appID, methodName, http.MethodDelete); err != nil {
 		log.Error("invoke ", methodName, " with ", http.MethodDelete, err)
 		log.Error("invoke Response:", string(c))
 		return err
@@ -9,8 +9,7 @@ import (
 	"github.com/tkeel-io/kit/log"
 )
 
-func (c Client) PatchEntity(entityID string, data []map[string]interface{}) error {
-	ctx := context.Background()
+func (c *DaprClient) PatchEntity(ctx context.Context, entityID string, data []map[string]interface{}) error {
 	patchEntityURL := PatchEntityURL(entityID)
 
 	contentData, err := json.Marshal(data)
@@ -23,19 +22,26 @@ func (c Client) PatchEntity(entityID string, data []map[string]interface{}) erro
 	}
 
 	log.Infof("invoke patch entity %s \n By %s \n Content.Data:%v", patchEntityURL, http.MethodPut, string(content.Data))
-	if re, err := c.daprClient.InvokeMethodWithContent(ctx, AppID, patchEntityURL, http.MethodPut, content); err != nil {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	if re, err := client.InvokeMethodWithContent(ctx, c.appID, patchEntityURL, http.MethodPut, content); err != nil {
 		log.Errorf("invoke %s \n and Request Body:%v \n Response Content: %s \n err:%v", patchEntityURL, content, string(re), err)
 		return err
 	}
 	return nil
 }
 
-func (c Client) GetDeviceEntity(entityID string) (*Entity, error) {
-	ctx := context.Background()
+func (c *DaprClient) GetDeviceEntity(ctx context.Context, entityID string) (*Entity, error) {
 	queryEntityURL := QueryDeviceEntityURL(entityID)
 
 	log.Debugf("invoke get device entity %s", queryEntityURL)
-	resp, err := c.daprClient.InvokeMethod(ctx, AppID, queryEntityURL, http.MethodGet)
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.InvokeMethod(ctx, c.appID, queryEntityURL, http.MethodGet)
 	if err != nil {
 		log.Errorf("invoke %s \n response content: %s \n err:%v", queryEntityURL, string(resp), err)
 		return nil, err
@@ -53,8 +59,7 @@ func (c Client) GetDeviceEntity(entityID string) (*Entity, error) {
 	return &response.Data, nil
 }
 
-func (c Client) CreateEntity(id, userID, source string) (*Entity, error) {
-	ctx := context.Background()
+func (c *DaprClient) CreateEntity(ctx context.Context, id, userID, source string) (*Entity, error) {
 	createEntityURL := CreateEntityURL(id, userID, source)
 
 	log.Debugf("invoke create entity %s", createEntityURL)
@@ -62,7 +67,11 @@ func (c Client) CreateEntity(id, userID, source string) (*Entity, error) {
 		Data:        []byte(`{}`),
 		ContentType: MimeJson,
 	}
-	resp, err := c.daprClient.InvokeMethodWithContent(ctx, AppID, createEntityURL, http.MethodPost, &data)
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.InvokeMethodWithContent(ctx, c.appID, createEntityURL, http.MethodPost, &data)
 	if err != nil {
 		log.Errorf("invoke %s \n response content: %s \n err:%v", createEntityURL, string(resp), err)
 		return nil, err
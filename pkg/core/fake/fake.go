@@ -0,0 +1,385 @@
+// Package fake provides an in-memory core for tests. It records the
+// subscriptions and the entity properties it receives and publishes property
+// updates to the subscriptions the way core does.
+package fake
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	pb "github.com/tkeel-io/core-broker/api/topic/v1"
+	"github.com/tkeel-io/core-broker/pkg/config"
+	"github.com/tkeel-io/core-broker/pkg/core"
+	"github.com/tkeel-io/core-broker/pkg/types"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// methods of core.Client, used to inject errors and count calls.
+const (
+	MethodSubscribe       = "Subscribe"
+	MethodUnsubscribe     = "Unsubscribe"
+	MethodPatchEntity     = "PatchEntity"
+	MethodGetDeviceEntity = "GetDeviceEntity"
+	MethodCreateEntity    = "CreateEntity"
+)
+
+var (
+	ErrEntityNotFound       = errors.New("entity not found")
+	ErrEntityExists         = errors.New("entity already exists")
+	ErrSubscriptionNotFound = errors.New("subscription not found")
+)
+
+var _ core.Client = (*Core)(nil)
+
+// Subscription is a subscription created in the fake core.
+type Subscription struct {
+	ID       string
+	EntityID string
+	Topic    string
+	UserID   string
+	Field    string
+}
+
+// Handler receives the events published by the fake core.
+type Handler func(ctx context.Context, req *pb.TopicEventRequest) (*pb.TopicEventResponse, error)
+
+type entity struct {
+	id         string
+	owner      string
+	source     string
+	properties map[string]interface{}
+}
+
+type Core struct {
+	lock          sync.Mutex
+	entities      map[string]*entity
+	subscriptions map[string]Subscription
+	errs          map[string]error
+	delays        map[string]time.Duration
+	calls         map[string]int
+	handler       Handler
+	// dapr names the app and the pubsub of the events, as the default
+	// settings.
+	dapr config.Dapr
+}
+
+func New() *Core {
+	return &Core{
+		dapr:          config.Default().Dapr,
+		entities:      make(map[string]*entity),
+		subscriptions: make(map[string]Subscription),
+		errs:          make(map[string]error),
+		delays:        make(map[string]time.Duration),
+		calls:         make(map[string]int),
+	}
+}
+
+// AddEntity adds a device entity with the properties, like basicInfo and
+// sysField, to the fake core.
+func (c *Core) AddEntity(id, owner string, properties map[string]interface{}) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if properties == nil {
+		properties = make(map[string]interface{})
+	}
+	c.entities[id] = &entity{id: id, owner: owner, source: "dm", properties: copyMap(properties)}
+}
+
+// Property returns the value of the entity property at the dotted path.
+func (c *Core) Property(entityID, path string) (interface{}, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	e, ok := c.entities[entityID]
+	if !ok {
+		return nil, false
+	}
+	return getPath(e.properties, path)
+}
+
+// Subscriptions lists the subscriptions of the entity, all of them if
+// entityID is empty.
+func (c *Core) Subscriptions(entityID string) []Subscription {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	out := make([]Subscription, 0)
+	for _, s := range c.subscriptions {
+		if entityID == "" || s.EntityID == entityID {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// SetError makes the method fail with err, a nil err makes it succeed again.
+func (c *Core) SetError(method string, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if err == nil {
+		delete(c.errs, method)
+		return
+	}
+	c.errs[method] = err
+}
+
+// SetDelay makes the method take d before it is applied, like a slow core.
+// The method fails with the error of the context if it is done first.
+func (c *Core) SetDelay(method string, d time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.delays[method] = d
+}
+
+// Calls returns how many times the method has been called.
+func (c *Core) Calls(method string) int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.calls[method]
+}
+
+// OnEvent sets the handler the events are published to, typically
+// service.TopicService.TopicEventHandler.
+func (c *Core) OnEvent(handler Handler) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.handler = handler
+}
+
+// Emit updates the properties of the entity and publishes the update to the
+// subscriptions of the entity, it returns the first error of the handler.
+func (c *Core) Emit(entityID string, properties map[string]interface{}) error {
+	c.lock.Lock()
+	e, ok := c.entities[entityID]
+	if !ok {
+		c.lock.Unlock()
+		return ErrEntityNotFound
+	}
+	for path, value := range properties {
+		setPath(e.properties, path, value)
+	}
+	subscriptions := make([]Subscription, 0)
+	for _, s := range c.subscriptions {
+		if s.EntityID == entityID {
+			subscriptions = append(subscriptions, s)
+		}
+	}
+	handler := c.handler
+	c.lock.Unlock()
+
+	if handler == nil {
+		return nil
+	}
+	for _, s := range subscriptions {
+		data, err := structpb.NewValue(map[string]interface{}{
+			"id":         s.ID,
+			"properties": copyMap(properties),
+			"ts":         float64(time.Now().UnixMilli()),
+		})
+		if err != nil {
+			return errors.Wrap(err, "encode event")
+		}
+		if _, err = handler(context.Background(), &pb.TopicEventRequest{
+			Id:         uuid.New().String(),
+			Type:       "com.dapr.event.sent",
+			Source:     c.dapr.CoreAppID,
+			Topic:      s.Topic,
+			Pubsubname: c.dapr.PubsubName,
+			Data:       data,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Core) Subscribe(ctx context.Context, subscriptionID, entityID, topic string, userID string, fields ...string) error {
+	if err := c.wait(ctx, MethodSubscribe); err != nil {
+		return err
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if err := c.call(MethodSubscribe); err != nil {
+		return err
+	}
+	if subscriptionID == "" || entityID == "" || topic == "" {
+		return errors.New("subscriptionID, entityID or topic is empty")
+	}
+	field := "*"
+	if len(fields) == 1 {
+		field = fields[0]
+	}
+	c.subscriptions[subscriptionID] = Subscription{
+		ID:       subscriptionID,
+		EntityID: entityID,
+		Topic:    topic,
+		UserID:   userID,
+		Field:    field,
+	}
+	return nil
+}
+
+func (c *Core) Unsubscribe(ctx context.Context, subscriptionID, userID string) error {
+	if err := c.wait(ctx, MethodUnsubscribe); err != nil {
+		return err
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if err := c.call(MethodUnsubscribe); err != nil {
+		return err
+	}
+	if _, ok := c.subscriptions[subscriptionID]; !ok {
+		return ErrSubscriptionNotFound
+	}
+	delete(c.subscriptions, subscriptionID)
+	return nil
+}
+
+// PatchEntity applies the replace, add and remove operations on the
+// properties of the entity.
+func (c *Core) PatchEntity(ctx context.Context, entityID string, data []map[string]interface{}) error {
+	if err := c.wait(ctx, MethodPatchEntity); err != nil {
+		return err
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if err := c.call(MethodPatchEntity); err != nil {
+		return err
+	}
+	e, ok := c.entities[entityID]
+	if !ok {
+		return ErrEntityNotFound
+	}
+	for _, op := range data {
+		path := types.Interface2string(op["path"])
+		switch op["operator"] {
+		case "replace", "add":
+			setPath(e.properties, path, op["value"])
+		case "remove":
+			deletePath(e.properties, path)
+		default:
+			return errors.Errorf("unsupported patch operator %v", op["operator"])
+		}
+	}
+	return nil
+}
+
+func (c *Core) GetDeviceEntity(ctx context.Context, entityID string) (*core.Entity, error) {
+	if err := c.wait(ctx, MethodGetDeviceEntity); err != nil {
+		return nil, err
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if err := c.call(MethodGetDeviceEntity); err != nil {
+		return nil, err
+	}
+	e, ok := c.entities[entityID]
+	if !ok {
+		return nil, ErrEntityNotFound
+	}
+	return e.entity()
+}
+
+func (c *Core) CreateEntity(ctx context.Context, id, userID, source string) (*core.Entity, error) {
+	if err := c.wait(ctx, MethodCreateEntity); err != nil {
+		return nil, err
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if err := c.call(MethodCreateEntity); err != nil {
+		return nil, err
+	}
+	if _, ok := c.entities[id]; ok {
+		return nil, ErrEntityExists
+	}
+	e := &entity{id: id, owner: userID, source: source, properties: make(map[string]interface{})}
+	c.entities[id] = e
+	return e.entity()
+}
+
+func (c *Core) wait(ctx context.Context, method string) error {
+	c.lock.Lock()
+	delay := c.delays[method]
+	c.lock.Unlock()
+	if delay == 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (c *Core) call(method string) error {
+	c.calls[method]++
+	return c.errs[method]
+}
+
+func (e *entity) entity() (*core.Entity, error) {
+	out := &core.Entity{Id: e.id, Owner: e.owner, Source: e.source, Type: "device"}
+	content, err := json.Marshal(e.properties)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(content, &out.Properties); err != nil {
+		return nil, err
+	}
+	out.PropertyValues = copyMap(e.properties)
+	return out, nil
+}
+
+func getPath(m map[string]interface{}, path string) (interface{}, bool) {
+	keys := strings.Split(path, ".")
+	for _, key := range keys[:len(keys)-1] {
+		next, ok := m[key].(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		m = next
+	}
+	v, ok := m[keys[len(keys)-1]]
+	return v, ok
+}
+
+func setPath(m map[string]interface{}, path string, value interface{}) {
+	keys := strings.Split(path, ".")
+	for _, key := range keys[:len(keys)-1] {
+		next, ok := m[key].(map[string]interface{})
+		if !ok {
+			next = make(map[string]interface{})
+			m[key] = next
+		}
+		m = next
+	}
+	m[keys[len(keys)-1]] = value
+}
+
+func deletePath(m map[string]interface{}, path string) {
+	keys := strings.Split(path, ".")
+	for _, key := range keys[:len(keys)-1] {
+		next, ok := m[key].(map[string]interface{})
+		if !ok {
+			return
+		}
+		m = next
+	}
+	delete(m, keys[len(keys)-1])
+}
+
+func copyMap(m map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		if nested, ok := v.(map[string]interface{}); ok {
+			v = copyMap(nested)
+		}
+		out[k] = v
+	}
+	return out
+}
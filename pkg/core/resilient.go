@@ -0,0 +1,153 @@
+package core
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core-broker/pkg/config"
+	"github.com/tkeel-io/core-broker/pkg/metrics"
+	"github.com/tkeel-io/kit/log"
+)
+
+// invocation results of the core_invocations metric.
+const (
+	resultSuccess  = "success"
+	resultFailure  = "failure"
+	resultRejected = "rejected"
+)
+
+var _ Client = (*ResilientClient)(nil)
+
+// ResilientClient bounds every invocation of the wrapped client by a
+// deadline, retries the idempotent ones with a jittered exponential backoff
+// and fails fast while its circuit breaker is open.
+type ResilientClient struct {
+	next    Client
+	conf    config.Core
+	breaker *Breaker
+	sleep   func(ctx context.Context, d time.Duration) error
+}
+
+func NewResilientClient(next Client, conf config.Core) *ResilientClient {
+	return &ResilientClient{
+		next: next,
+		conf: conf,
+		breaker: NewBreaker(conf.BreakerThreshold, conf.BreakerCooldown, func(state BreakerState) {
+			log.Warnf("core circuit breaker is %s", state)
+			metrics.CollectorCoreCircuitState.Set(float64(state))
+		}),
+		sleep: sleep,
+	}
+}
+
+// Subscribe is not retried, core rejects the creation of a subscription
+// which an attempt timing out may have created.
+func (c *ResilientClient) Subscribe(ctx context.Context, subscriptionID, entityID, topic string, userID string, fields ...string) error {
+	return c.invoke(ctx, "Subscribe", false, func(ctx context.Context) error {
+		return c.next.Subscribe(ctx, subscriptionID, entityID, topic, userID, fields...)
+	})
+}
+
+func (c *ResilientClient) Unsubscribe(ctx context.Context, subscriptionID, userID string) error {
+	return c.invoke(ctx, "Unsubscribe", true, func(ctx context.Context) error {
+		return c.next.Unsubscribe(ctx, subscriptionID, userID)
+	})
+}
+
+// PatchEntity is retried only if every operation replaces a value.
+func (c *ResilientClient) PatchEntity(ctx context.Context, entityID string, data []map[string]interface{}) error {
+	idempotent := true
+	for _, op := range data {
+		if op["operator"] != "replace" {
+			idempotent = false
+		}
+	}
+	return c.invoke(ctx, "PatchEntity", idempotent, func(ctx context.Context) error {
+		return c.next.PatchEntity(ctx, entityID, data)
+	})
+}
+
+func (c *ResilientClient) GetDeviceEntity(ctx context.Context, entityID string) (entity *Entity, err error) {
+	err = c.invoke(ctx, "GetDeviceEntity", true, func(ctx context.Context) error {
+		entity, err = c.next.GetDeviceEntity(ctx, entityID)
+		return err
+	})
+	return entity, err
+}
+
+func (c *ResilientClient) CreateEntity(ctx context.Context, id, userID, source string) (entity *Entity, err error) {
+	err = c.invoke(ctx, "CreateEntity", false, func(ctx context.Context) error {
+		entity, err = c.next.CreateEntity(ctx, id, userID, source)
+		return err
+	})
+	return entity, err
+}
+
+func (c *ResilientClient) BreakerState() BreakerState {
+	return c.breaker.State()
+}
+
+func (c *ResilientClient) invoke(ctx context.Context, method string, idempotent bool, call func(ctx context.Context) error) error {
+	attempts := 1
+	if idempotent {
+		attempts += c.conf.Retries
+	}
+	for attempt := 0; ; attempt++ {
+		if err := c.breaker.Allow(); err != nil {
+			metrics.CollectorCoreInvocations.WithLabelValues(method, resultRejected).Inc()
+			return err
+		}
+		attemptCtx, cancel := context.WithTimeout(ctx, c.conf.Timeout)
+		start := time.Now()
+		err := call(attemptCtx)
+		cancel()
+		metrics.CollectorCoreDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+		if err == nil {
+			c.breaker.Done(true)
+			metrics.CollectorCoreInvocations.WithLabelValues(method, resultSuccess).Inc()
+			return nil
+		}
+		if ctx.Err() != nil {
+			// The caller giving up says nothing about the health of core.
+			c.breaker.Release()
+		} else {
+			c.breaker.Done(false)
+		}
+		metrics.CollectorCoreInvocations.WithLabelValues(method, resultFailure).Inc()
+		if attempt+1 >= attempts || ctx.Err() != nil {
+			return err
+		}
+		log.Warnf("invoke core %s attempt %d err: %v", method, attempt+1, err)
+		metrics.CollectorCoreRetries.WithLabelValues(method).Inc()
+		if err = c.sleep(ctx, c.backoff(attempt)); err != nil {
+			return errors.Wrapf(err, "retry core %s", method)
+		}
+	}
+}
+
+// backoff doubles from RetryBackoff up to RetryMaxBackoff and is jittered
+// between half and all of it.
+func (c *ResilientClient) backoff(attempt int) time.Duration {
+	d := c.conf.RetryBackoff
+	for i := 0; i < attempt && d < c.conf.RetryMaxBackoff; i++ {
+		d *= 2
+	}
+	if d > c.conf.RetryMaxBackoff {
+		d = c.conf.RetryMaxBackoff
+	}
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(d-half)+1))
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,155 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/core-broker/pkg/config"
+)
+
+type flakyClient struct {
+	Client
+	errs  []error
+	calls int
+	delay time.Duration
+}
+
+func (c *flakyClient) call(ctx context.Context) error {
+	c.calls++
+	if c.delay != 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(c.delay):
+		}
+	}
+	if len(c.errs) == 0 {
+		return nil
+	}
+	err := c.errs[0]
+	c.errs = c.errs[1:]
+	return err
+}
+
+func (c *flakyClient) Subscribe(ctx context.Context, subscriptionID, entityID, topic string, userID string, fields ...string) error {
+	return c.call(ctx)
+}
+
+func (c *flakyClient) Unsubscribe(ctx context.Context, subscriptionID, userID string) error {
+	return c.call(ctx)
+}
+
+func (c *flakyClient) PatchEntity(ctx context.Context, entityID string, data []map[string]interface{}) error {
+	return c.call(ctx)
+}
+
+func newTestResilientClient(next Client, threshold int) *ResilientClient {
+	c := NewResilientClient(next, config.Core{
+		Timeout:          50 * time.Millisecond,
+		Retries:          2,
+		RetryBackoff:     time.Millisecond,
+		RetryMaxBackoff:  4 * time.Millisecond,
+		BreakerThreshold: threshold,
+		BreakerCooldown:  time.Minute,
+	})
+	c.sleep = func(context.Context, time.Duration) error { return nil }
+	return c
+}
+
+func TestResilientClientRetries(t *testing.T) {
+	errUnavailable := errors.New("unavailable")
+	next := &flakyClient{errs: []error{errUnavailable, errUnavailable}}
+	c := newTestResilientClient(next, 0)
+	assert.Nil(t, c.Unsubscribe(context.Background(), "cb-1", "usr-1"))
+	assert.Equal(t, 3, next.calls)
+
+	// Creating a subscription is not idempotent.
+	next = &flakyClient{errs: []error{errUnavailable}}
+	c = newTestResilientClient(next, 0)
+	assert.Equal(t, errUnavailable, c.Subscribe(context.Background(), "cb-1", "iotd-1", "topic", "usr-1"))
+	assert.Equal(t, 1, next.calls)
+
+	next = &flakyClient{errs: []error{errUnavailable}}
+	c = newTestResilientClient(next, 0)
+	assert.NotNil(t, c.PatchEntity(context.Background(), "iotd-1", []map[string]interface{}{{"operator": "add"}}))
+	assert.Equal(t, 1, next.calls)
+	next = &flakyClient{errs: []error{errUnavailable}}
+	c = newTestResilientClient(next, 0)
+	assert.Nil(t, c.PatchEntity(context.Background(), "iotd-1", []map[string]interface{}{{"operator": "replace"}}))
+	assert.Equal(t, 2, next.calls)
+}
+
+func TestResilientClientDeadline(t *testing.T) {
+	next := &flakyClient{delay: time.Second}
+	c := newTestResilientClient(next, 0)
+	start := time.Now()
+	err := c.Unsubscribe(context.Background(), "cb-1", "usr-1")
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Equal(t, 3, next.calls)
+	assert.Less(t, int64(time.Since(start)), int64(time.Second))
+
+	// A canceled caller is not retried.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	next = &flakyClient{delay: time.Second}
+	c = newTestResilientClient(next, 1)
+	assert.True(t, errors.Is(c.Unsubscribe(ctx, "cb-1", "usr-1"), context.Canceled))
+	assert.Equal(t, 1, next.calls)
+	assert.Equal(t, BreakerClosed, c.BreakerState())
+}
+
+func TestResilientClientBreaker(t *testing.T) {
+	errUnavailable := errors.New("unavailable")
+	next := &flakyClient{errs: []error{errUnavailable, errUnavailable, errUnavailable}}
+	c := newTestResilientClient(next, 3)
+	now := time.Now()
+	c.breaker.now = func() time.Time { return now }
+
+	assert.Equal(t, errUnavailable, c.Unsubscribe(context.Background(), "cb-1", "usr-1"))
+	assert.Equal(t, BreakerOpen, c.BreakerState())
+	assert.Equal(t, ErrCircuitOpen, c.Unsubscribe(context.Background(), "cb-1", "usr-1"))
+	assert.Equal(t, 3, next.calls)
+
+	now = now.Add(time.Minute)
+	assert.Nil(t, c.Unsubscribe(context.Background(), "cb-1", "usr-1"))
+	assert.Equal(t, BreakerClosed, c.BreakerState())
+	assert.Equal(t, 4, next.calls)
+}
+
+func TestBreakerHalfOpen(t *testing.T) {
+	b := NewBreaker(1, time.Minute, nil)
+	now := time.Now()
+	b.now = func() time.Time { return now }
+	assert.Nil(t, b.Allow())
+	b.Done(false)
+	assert.Equal(t, ErrCircuitOpen, b.Allow())
+
+	now = now.Add(time.Minute)
+	assert.Nil(t, b.Allow())
+	assert.Equal(t, BreakerHalfOpen, b.State())
+	// Only one probe at a time.
+	assert.Equal(t, ErrCircuitOpen, b.Allow())
+	b.Done(false)
+	assert.Equal(t, BreakerOpen, b.State())
+	assert.Equal(t, ErrCircuitOpen, b.Allow())
+
+	b = NewBreaker(0, time.Minute, nil)
+	b.Done(false)
+	assert.Nil(t, b.Allow())
+}
+
+func TestBackoff(t *testing.T) {
+	c := newTestResilientClient(nil, 0)
+	for attempt := 0; attempt < 5; attempt++ {
+		d := c.backoff(attempt)
+		max := time.Millisecond << attempt
+		if max > 4*time.Millisecond {
+			max = 4 * time.Millisecond
+		}
+		assert.GreaterOrEqual(t, int64(d), int64(max/2))
+		assert.LessOrEqual(t, int64(d), int64(max))
+	}
+}
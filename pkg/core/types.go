@@ -1,6 +1,7 @@
 package core
 
-const AppID = "core"
+import "encoding/json"
+
 const MimeJson = "application/json"
 
 type GetEntityResponse struct {
@@ -24,6 +25,25 @@ type Entity struct {
 	Properties Property
 	Source     string
 	Type       string
+	// PropertyValues are all properties of the entity, including the ones
+	// Properties has no field for, like telemetry and attributes.
+	PropertyValues map[string]interface{} `json:"-"`
+}
+
+// UnmarshalJSON also keeps all properties in PropertyValues.
+func (e *Entity) UnmarshalJSON(data []byte) error {
+	type plain Entity
+	if err := json.Unmarshal(data, (*plain)(e)); err != nil {
+		return err
+	}
+	values := struct {
+		Properties map[string]interface{}
+	}{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	e.PropertyValues = values.Properties
+	return nil
 }
 
 type Property struct {
@@ -50,6 +70,7 @@ type BasicInfo struct {
 type SysField struct {
 	ID            string `json:"_id"`
 	Owner         string `json:"_owner"`
+	TenantID      string `json:"_tenantId"`
 	Source        string `json:"_source"`
 	Status        string `json:"_status"`
 	SubscribeAddr string `json:"_subscribeAddr"`
@@ -0,0 +1,231 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core-broker/pkg/metrics"
+	"github.com/tkeel-io/core-broker/pkg/model"
+	"github.com/tkeel-io/kit/log"
+	"gorm.io/gorm"
+)
+
+// WindowResult is the message delivered for every window of a subscribe in
+// model.ModeAggregate.
+type WindowResult struct {
+	SubscribeID uint                      `json:"subscribe_id"`
+	EntityID    string                    `json:"entity_id,omitempty"`
+	WindowStart int64                     `json:"window_start"`
+	WindowEnd   int64                     `json:"window_end"`
+	Values      map[string]AggregateValue `json:"values"`
+}
+
+type AggregateValue struct {
+	Min   float64 `json:"min"`
+	Max   float64 `json:"max"`
+	Avg   float64 `json:"avg"`
+	Count uint64  `json:"count"`
+}
+
+type windowKey struct {
+	subscribeID uint
+	entityID    string
+	start       int64
+}
+
+type partialWindow struct {
+	window model.SubscribeWindow
+	tenant string
+	stats  map[string]model.PropertyStats
+}
+
+// Aggregator buffers the numeric property updates of subscribes in
+// model.ModeAggregate in memory, Flush merges them into the stored windows.
+type Aggregator struct {
+	locker   sync.Mutex
+	partials map[windowKey]*partialWindow
+	now      func() time.Time
+}
+
+func NewAggregator() *Aggregator {
+	return &Aggregator{
+		partials: make(map[windowKey]*partialWindow),
+		now:      time.Now,
+	}
+}
+
+// Add buffers the numeric properties of an update, it reports false when the
+// window of the update has already been delivered.
+func (a *Aggregator) Add(member model.SubscribeEntities, at time.Time, properties map[string]interface{}) bool {
+	window := windowOf(member, at)
+	if window.Deadline <= a.now().Unix() {
+		metrics.CollectorDeliveryLateEvents.WithLabelValues(member.Subscribe.TenantID).Inc()
+		return false
+	}
+	values := make(map[string]float64)
+	numericProperties("", properties, values)
+	if len(values) == 0 {
+		return true
+	}
+
+	key := windowKey{subscribeID: window.SubscribeID, entityID: window.EntityID, start: window.WindowStart}
+	a.locker.Lock()
+	defer a.locker.Unlock()
+	partial, ok := a.partials[key]
+	if !ok {
+		partial = &partialWindow{
+			window: window,
+			tenant: member.Subscribe.TenantID,
+			stats:  make(map[string]model.PropertyStats),
+		}
+		a.partials[key] = partial
+	}
+	for property, v := range values {
+		s := partial.stats[property]
+		s.Observe(v)
+		partial.stats[property] = s
+	}
+	return true
+}
+
+// Flush merges the buffered partial windows into the stored windows, partial
+// windows which fail to merge are kept for the next Flush.
+func (a *Aggregator) Flush() error {
+	a.locker.Lock()
+	partials := a.partials
+	a.partials = make(map[windowKey]*partialWindow)
+	a.locker.Unlock()
+
+	var lastErr error
+	for key, partial := range partials {
+		err := model.AccumulateWindow(partial.window, partial.stats)
+		if err == nil {
+			continue
+		}
+		if errors.Is(err, model.ErrWindowClosed) {
+			metrics.CollectorDeliveryLateEvents.WithLabelValues(partial.tenant).Inc()
+			continue
+		}
+		lastErr = err
+		a.restore(key, partial)
+	}
+	return lastErr
+}
+
+func (a *Aggregator) restore(key windowKey, partial *partialWindow) {
+	a.locker.Lock()
+	defer a.locker.Unlock()
+	current, ok := a.partials[key]
+	if !ok {
+		a.partials[key] = partial
+		return
+	}
+	for property, s := range partial.stats {
+		merged := current.stats[property]
+		merged.Merge(s)
+		current.stats[property] = merged
+	}
+}
+
+// Emit delivers the stored windows whose deadline is not after the given time.
+func (a *Aggregator) Emit(publisher Publisher, until time.Time) error {
+	windows, err := model.DueWindows(until.Unix())
+	if err != nil {
+		return err
+	}
+	for i := range windows {
+		claimed, err := model.ClaimWindow(windows[i].ID)
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			continue
+		}
+		if err = a.emit(publisher, &windows[i]); err != nil {
+			log.Errorf("emit window %d of subscribe %d err: %v", windows[i].ID, windows[i].SubscribeID, err)
+			if err = model.ReleaseWindow(windows[i].ID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (a *Aggregator) emit(publisher Publisher, window *model.SubscribeWindow) error {
+	subscribe := model.Subscribe{}
+	if err := model.DB().First(&subscribe, window.SubscribeID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Debugf("drop window %d of deleted subscribe %d", window.ID, window.SubscribeID)
+			return nil
+		}
+		return err
+	}
+	data, err := windowResult(window)
+	if err != nil {
+		return err
+	}
+	if err = publisher.Publish(context.Background(), subscribe.Endpoint, data); err != nil {
+		return errors.Wrap(err, "publish window result")
+	}
+	metrics.CollectorDeliveryWindows.WithLabelValues(subscribe.TenantID).Inc()
+	return nil
+}
+
+func windowResult(window *model.SubscribeWindow) ([]byte, error) {
+	stats, err := window.Stats()
+	if err != nil {
+		return nil, err
+	}
+	result := WindowResult{
+		SubscribeID: window.SubscribeID,
+		EntityID:    window.EntityID,
+		WindowStart: window.WindowStart,
+		WindowEnd:   window.WindowEnd,
+		Values:      make(map[string]AggregateValue, len(stats)),
+	}
+	for property, s := range stats {
+		result.Values[property] = AggregateValue{Min: s.Min, Max: s.Max, Avg: s.Avg(), Count: s.Count}
+	}
+	return json.Marshal(result)
+}
+
+// windowOf returns the window of the subscribe of member the given time falls
+// into.
+func windowOf(member model.SubscribeEntities, at time.Time) model.SubscribeWindow {
+	opts := member.Subscribe.Aggregate
+	size := int64(opts.Window)
+	if size <= 0 {
+		size = 1
+	}
+	start := at.Unix() - at.Unix()%size
+	window := model.SubscribeWindow{
+		SubscribeID: member.SubscribeID,
+		WindowStart: start,
+		WindowEnd:   start + size,
+		Deadline:    start + size + int64(opts.Lateness),
+	}
+	if opts.Scope != model.AggregateScopeSubscribe {
+		window.EntityID = member.EntityID
+	}
+	return window
+}
+
+// numericProperties flattens the numeric leaves of properties into out, keyed
+// by their dot separated path.
+func numericProperties(prefix string, properties map[string]interface{}, out map[string]float64) {
+	for k, v := range properties {
+		path := k
+		if prefix != "" {
+			path = prefix + "." + k
+		}
+		switch value := v.(type) {
+		case float64:
+			out[path] = value
+		case map[string]interface{}:
+			numericProperties(path, value, out)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package delivery
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/core-broker/pkg/model"
+)
+
+func TestWindowOf(t *testing.T) {
+	member := model.SubscribeEntities{
+		EntityID:    "device-1",
+		SubscribeID: 3,
+		Subscribe: model.Subscribe{
+			Mode:      model.ModeAggregate,
+			Aggregate: model.AggregateOptions{Window: 60, Lateness: 5},
+		},
+	}
+	window := windowOf(member, time.Unix(1650000030, 0))
+	assert.Equal(t, int64(1650000000), window.WindowStart)
+	assert.Equal(t, int64(1650000060), window.WindowEnd)
+	assert.Equal(t, int64(1650000065), window.Deadline)
+	assert.Equal(t, "device-1", window.EntityID)
+
+	member.Subscribe.Aggregate.Scope = model.AggregateScopeSubscribe
+	window = windowOf(member, time.Unix(1650000030, 0))
+	assert.Equal(t, "", window.EntityID)
+}
+
+func TestNumericProperties(t *testing.T) {
+	properties := map[string]interface{}{
+		"temperature": 21.5,
+		"name":        "sensor",
+		"battery": map[string]interface{}{
+			"level":    80.0,
+			"charging": true,
+		},
+	}
+	values := make(map[string]float64)
+	numericProperties("", properties, values)
+	assert.Equal(t, map[string]float64{"temperature": 21.5, "battery.level": 80}, values)
+}
+
+func TestAggregatorAddLate(t *testing.T) {
+	a := NewAggregator()
+	a.now = func() time.Time { return time.Unix(999, 0) }
+	member := model.SubscribeEntities{
+		EntityID:    "device-1",
+		SubscribeID: 1,
+		Subscribe: model.Subscribe{
+			Mode:      model.ModeAggregate,
+			Aggregate: model.AggregateOptions{Window: 10},
+		},
+	}
+	assert.False(t, a.Add(member, time.Unix(985, 0), map[string]interface{}{"v": 1.0}))
+	assert.True(t, a.Add(member, time.Unix(995, 0), map[string]interface{}{"v": 1.0}))
+	assert.True(t, a.Add(member, time.Unix(998, 0), map[string]interface{}{"v": 3.0}))
+
+	partial := a.partials[windowKey{subscribeID: 1, entityID: "device-1", start: 990}]
+	assert.NotNil(t, partial)
+	assert.Equal(t, model.PropertyStats{Count: 2, Sum: 4, Min: 1, Max: 3}, partial.stats["v"])
+}
+
+func TestWindowResult(t *testing.T) {
+	window := &model.SubscribeWindow{
+		SubscribeID: 1,
+		EntityID:    "device-1",
+		WindowStart: 990,
+		WindowEnd:   1000,
+		Values:      `{"v":{"count":2,"sum":4,"min":1,"max":3}}`,
+	}
+	data, err := windowResult(window)
+	assert.Nil(t, err)
+
+	result := WindowResult{}
+	assert.Nil(t, json.Unmarshal(data, &result))
+	assert.Equal(t, AggregateValue{Min: 1, Max: 3, Avg: 2, Count: 2}, result.Values["v"])
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package delivery processes the property updates of subscriptions which are
+// not delivered by core directly (see model.Subscribe.Processed) and publishes
+// the results to the subscription endpoints.
+package delivery
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	dapr "github.com/dapr/go-sdk/client"
+	"github.com/pkg/errors"
+	pb "github.com/tkeel-io/core-broker/api/topic/v1"
+	"github.com/tkeel-io/core-broker/pkg/deviceutil"
+	"github.com/tkeel-io/core-broker/pkg/model"
+	"github.com/tkeel-io/core-broker/pkg/types"
+	"github.com/tkeel-io/kit/log"
+)
+
+const (
+	tickInterval = time.Second
+	// emitDelay gives other broker instances time to flush their partial
+	// windows before a window is delivered.
+	emitDelay = 2 * tickInterval
+	// reloadInterval limits how often unknown core subscriptions trigger a
+	// reload of the memberships.
+	reloadInterval  = 5 * time.Second
+	refreshInterval = 30 * time.Second
+	purgeInterval   = time.Minute
+	windowRetention = time.Hour
+)
+
+var (
+	// ErrUnknownSubscription means the event belongs to no processed subscribe.
+	ErrUnknownSubscription = errors.New("unknown subscription")
+	// ErrLateEvent means the event arrived after its window was delivered.
+	ErrLateEvent = errors.New("late event")
+)
+
+// Publisher publishes processed data to a subscription endpoint.
+type Publisher interface {
+	Publish(ctx context.Context, topic string, data []byte) error
+}
+
+type daprPublisher struct {
+	pubsubName string
+	once       sync.Once
+	client     dapr.Client
+	err        error
+}
+
+// NewDaprPublisher publishes to the broker pubsub of the name, the dapr
+// client is created on first use.
+func NewDaprPublisher(pubsubName string) Publisher {
+	return &daprPublisher{pubsubName: pubsubName}
+}
+
+func (p *daprPublisher) Publish(ctx context.Context, topic string, data []byte) error {
+	p.once.Do(func() {
+		p.client, p.err = dapr.NewClient()
+	})
+	if p.err != nil {
+		return errors.Wrap(p.err, "init dapr client error")
+	}
+	return p.client.PublishEvent(ctx, p.pubsubName, topic, data)
+}
+
+type Processor struct {
+	publisher  Publisher
+	entities   *deviceutil.EntityCache
+	aggregator *Aggregator
+	filter     *ChangeFilter
+
+	locker   sync.RWMutex
+	members  map[string]model.SubscribeEntities // core subscription ID -> membership
+	loadedAt time.Time
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewProcessor publishes the processed data with the publisher, the events
+// invalidate the metadata of their entities cached in entities.
+func NewProcessor(publisher Publisher, entities *deviceutil.EntityCache) *Processor {
+	return &Processor{
+		publisher:  publisher,
+		entities:   entities,
+		aggregator: NewAggregator(),
+		filter:     NewChangeFilter(),
+		members:    make(map[string]model.SubscribeEntities),
+		stop:       make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+}
+
+// Handle processes a core event published to types.DeliveryTopic.
+func (p *Processor) Handle(req *pb.TopicEventRequest) error {
+	kv, ok := req.Data.AsInterface().(map[string]interface{})
+	if !ok {
+		return errors.New("unexpected event data")
+	}
+	member, err := p.member(types.Interface2string(kv["id"]))
+	if err != nil {
+		return err
+	}
+	properties, _ := kv["properties"].(map[string]interface{})
+	p.entities.Invalidate(member.EntityID, properties)
+
+	switch member.Subscribe.Mode {
+	case model.ModeAggregate:
+		if !p.aggregator.Add(member, eventTime(kv), properties) {
+			return ErrLateEvent
+		}
+	case model.ModeOnChange:
+		return p.filter.Deliver(p.publisher, member, kv)
+	default:
+		log.Warnf("subscribe %d has no processing for mode %s", member.SubscribeID, member.Subscribe.Mode)
+	}
+	return nil
+}
+
+// Run processes the buffered data until Stop is called.
+func (p *Processor) Run() {
+	ticker := time.NewTicker(tickInterval)
+	defer ticker.Stop()
+	var lastPurge time.Time
+	for {
+		select {
+		case <-p.stop:
+			if err := p.aggregator.Flush(); err != nil {
+				log.Error("flush aggregation windows err:", err)
+			}
+			close(p.done)
+			return
+		case now := <-ticker.C:
+			if err := p.aggregator.Flush(); err != nil {
+				log.Error("flush aggregation windows err:", err)
+			}
+			if err := p.aggregator.Emit(p.publisher, now.Add(-emitDelay)); err != nil {
+				log.Error("emit aggregation windows err:", err)
+			}
+			if now.Sub(p.lastLoad()) >= refreshInterval {
+				if err := p.reload(); err != nil {
+					log.Error("reload processed subscriptions err:", err)
+				}
+			}
+			if now.Sub(lastPurge) >= purgeInterval {
+				lastPurge = now
+				if err := model.PurgeWindows(now.Add(-windowRetention).Unix()); err != nil {
+					log.Error("purge aggregation windows err:", err)
+				}
+				if err := model.PurgeLastValues(); err != nil {
+					log.Error("purge last values err:", err)
+				}
+				if err := model.RollbackPendingMemberships(context.Background()); err != nil {
+					log.Error("roll back pending memberships err:", err)
+				}
+			}
+		}
+	}
+}
+
+// Stop flushes the buffered data and stops Run.
+func (p *Processor) Stop() {
+	close(p.stop)
+	<-p.done
+}
+
+func (p *Processor) member(subscriptionID string) (model.SubscribeEntities, error) {
+	p.locker.RLock()
+	member, ok := p.members[subscriptionID]
+	loadedAt := p.loadedAt
+	p.locker.RUnlock()
+	if ok {
+		return member, nil
+	}
+	if time.Since(loadedAt) < reloadInterval {
+		return member, ErrUnknownSubscription
+	}
+	if err := p.reload(); err != nil {
+		return member, errors.Wrap(err, "load processed subscriptions")
+	}
+	p.locker.RLock()
+	defer p.locker.RUnlock()
+	if member, ok = p.members[subscriptionID]; !ok {
+		return member, ErrUnknownSubscription
+	}
+	return member, nil
+}
+
+func (p *Processor) lastLoad() time.Time {
+	p.locker.RLock()
+	defer p.locker.RUnlock()
+	return p.loadedAt
+}
+
+func (p *Processor) reload() error {
+	members, err := model.ProcessedMemberships()
+	if err != nil {
+		return err
+	}
+	p.locker.Lock()
+	p.members = members
+	p.loadedAt = time.Now()
+	p.locker.Unlock()
+	return nil
+}
+
+// eventTime is the time core stamped the event with (milliseconds in "ts"),
+// or the arrival time for events without one.
+func eventTime(kv map[string]interface{}) time.Time {
+	if ts, ok := kv["ts"].(float64); ok && ts > 0 {
+		return time.UnixMilli(int64(ts))
+	}
+	return time.Now()
+}
@@ -0,0 +1,144 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core-broker/pkg/metrics"
+	"github.com/tkeel-io/core-broker/pkg/model"
+	"github.com/tkeel-io/core-broker/pkg/util"
+	"github.com/tkeel-io/kit/log"
+)
+
+// swapAttempts bounds how often an event is compared again after another
+// broker instance changed the last values in between.
+const swapAttempts = 3
+
+// ErrLastValuesContended means the last values kept changing while the event
+// was compared against them, the event is retried.
+var ErrLastValuesContended = errors.New("last values contended")
+
+// ChangeFilter delivers the property updates of subscribes in
+// model.ModeOnChange, suppressing the properties which did not change beyond
+// the deadband since they were last delivered. The last values are read from
+// the database for every event and swapped by their revision, so that the
+// broker instances agree on them.
+type ChangeFilter struct {
+	locks *util.KeyedMutex
+}
+
+func NewChangeFilter() *ChangeFilter {
+	return &ChangeFilter{locks: util.NewKeyedMutex()}
+}
+
+// Deliver publishes the changed properties of the event kv. The last values
+// are claimed before publishing and restored if the publish fails.
+func (f *ChangeFilter) Deliver(publisher Publisher, member model.SubscribeEntities, kv map[string]interface{}) error {
+	// Updates of one entity are filtered one at a time within an instance,
+	// so that its concurrent events do not contend for the revision.
+	unlock := f.locks.Lock(fmt.Sprintf("%d/%s", member.SubscribeID, member.EntityID))
+	defer unlock()
+
+	properties, _ := kv["properties"].(map[string]interface{})
+	for attempt := 0; attempt < swapAttempts; attempt++ {
+		last, revision, err := model.LastValues(member.SubscribeID, member.EntityID)
+		if err != nil {
+			return errors.Wrap(err, "load last values")
+		}
+		delivered := make(map[string]interface{})
+		changed := changedProperties("", properties, last, member.Subscribe.Deadband, delivered)
+		if len(delivered) == 0 {
+			metrics.CollectorDeliverySuppressed.WithLabelValues(member.Subscribe.TenantID).Inc()
+			return nil
+		}
+
+		next := make(map[string]interface{}, len(last)+len(delivered))
+		for path, v := range last {
+			next[path] = v
+		}
+		for path, v := range delivered {
+			next[path] = v
+		}
+		swapped, err := model.SwapLastValues(member.SubscribeID, member.EntityID, revision, next)
+		if err != nil {
+			return errors.Wrap(err, "save last values")
+		}
+		if !swapped {
+			continue
+		}
+		if err = publish(publisher, member, kv, changed); err != nil {
+			// Unless another instance delivered a newer value meanwhile.
+			if _, restoreErr := model.SwapLastValues(member.SubscribeID, member.EntityID, revision+1, last); restoreErr != nil {
+				log.Error("restore last values err:", restoreErr)
+			}
+			return err
+		}
+		return nil
+	}
+	return ErrLastValuesContended
+}
+
+func publish(publisher Publisher, member model.SubscribeEntities, kv, changed map[string]interface{}) error {
+	event := make(map[string]interface{}, len(kv))
+	for k, v := range kv {
+		event[k] = v
+	}
+	event["properties"] = changed
+	data, err := json.Marshal(event)
+	if err != nil {
+		return errors.Wrap(err, "marshal changed properties")
+	}
+	return errors.Wrap(publisher.Publish(context.Background(), member.Subscribe.Endpoint, data), "publish changed properties")
+}
+
+// changedProperties returns the properties which changed compared to last,
+// keeping their nesting, and records the changed leaves in delivered keyed by
+// their dot separated path.
+func changedProperties(prefix string, properties, last map[string]interface{}, deadband model.DeadbandOptions, delivered map[string]interface{}) map[string]interface{} {
+	changed := make(map[string]interface{})
+	for k, v := range properties {
+		path := k
+		if prefix != "" {
+			path = prefix + "." + k
+		}
+		if nested, ok := v.(map[string]interface{}); ok {
+			if sub := changedProperties(path, nested, last, deadband, delivered); len(sub) > 0 {
+				changed[k] = sub
+			}
+			continue
+		}
+		previous, ok := last[path]
+		if !ok || exceeds(previous, v, deadband) {
+			changed[k] = v
+			delivered[path] = v
+		}
+	}
+	return changed
+}
+
+// exceeds reports whether current differs from previous, numeric values have
+// to differ by more than the deadband.
+func exceeds(previous, current interface{}, deadband model.DeadbandOptions) bool {
+	p, pok := previous.(float64)
+	c, cok := current.(float64)
+	if !pok || !cok {
+		return !reflect.DeepEqual(previous, current)
+	}
+	delta := math.Abs(c - p)
+	switch deadband.Type {
+	case model.DeadbandPercent:
+		if p == 0 {
+			return c != 0
+		}
+		return delta*100 > math.Abs(p)*deadband.Value
+	default:
+		if deadband.Value <= 0 {
+			return delta != 0
+		}
+		return delta > deadband.Value
+	}
+}
@@ -0,0 +1,109 @@
+package delivery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/core-broker/pkg/config"
+	"github.com/tkeel-io/core-broker/pkg/model"
+)
+
+func TestExceeds(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous interface{}
+		current  interface{}
+		deadband model.DeadbandOptions
+		excepted bool
+	}{
+		{"same value", 20.0, 20.0, model.DeadbandOptions{}, false},
+		{"any change", 20.0, 20.1, model.DeadbandOptions{}, true},
+		{"within absolute", 20.0, 20.5, model.DeadbandOptions{Type: model.DeadbandAbsolute, Value: 0.5}, false},
+		{"beyond absolute", 20.0, 19.4, model.DeadbandOptions{Type: model.DeadbandAbsolute, Value: 0.5}, true},
+		{"within percent", 200.0, 209.0, model.DeadbandOptions{Type: model.DeadbandPercent, Value: 5}, false},
+		{"beyond percent", 200.0, 189.0, model.DeadbandOptions{Type: model.DeadbandPercent, Value: 5}, true},
+		{"percent from zero", 0.0, 0.1, model.DeadbandOptions{Type: model.DeadbandPercent, Value: 5}, true},
+		{"same string", "on", "on", model.DeadbandOptions{Type: model.DeadbandAbsolute, Value: 1}, false},
+		{"changed string", "on", "off", model.DeadbandOptions{Type: model.DeadbandAbsolute, Value: 1}, true},
+		{"changed type", "1", 1.0, model.DeadbandOptions{}, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.excepted, exceeds(test.previous, test.current, test.deadband))
+		})
+	}
+}
+
+func TestChangedProperties(t *testing.T) {
+	last := map[string]interface{}{
+		"temperature":   20.0,
+		"status":        "online",
+		"battery.level": 80.0,
+	}
+	properties := map[string]interface{}{
+		"temperature": 20.2,
+		"status":      "offline",
+		"battery": map[string]interface{}{
+			"level":    79.9,
+			"charging": true,
+		},
+	}
+	delivered := make(map[string]interface{})
+	changed := changedProperties("", properties, last,
+		model.DeadbandOptions{Type: model.DeadbandAbsolute, Value: 0.5}, delivered)
+
+	assert.Equal(t, map[string]interface{}{
+		"status":  "offline",
+		"battery": map[string]interface{}{"charging": true},
+	}, changed)
+	assert.Equal(t, map[string]interface{}{
+		"status":           "offline",
+		"battery.charging": true,
+	}, delivered)
+}
+
+type countingPublisher struct {
+	published int
+	err       error
+}
+
+func (p *countingPublisher) Publish(ctx context.Context, topic string, data []byte) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.published++
+	return nil
+}
+
+func TestChangeFilterAcrossInstances(t *testing.T) {
+	assert.Nil(t, model.Setup(model.Options{Database: config.Database{Driver: model.DriverSQLite, DSN: "file::memory:"}}))
+	t.Cleanup(func() {
+		if pool, err := model.DB().DB(); err == nil {
+			pool.Close()
+		}
+	})
+	member := model.SubscribeEntities{
+		SubscribeID: 1,
+		EntityID:    "iotd-1",
+		Subscribe:   model.Subscribe{Mode: model.ModeOnChange, Endpoint: "ep1"},
+	}
+	event := func(temp float64) map[string]interface{} {
+		return map[string]interface{}{"properties": map[string]interface{}{"temp": temp}}
+	}
+	// Two broker instances share the database.
+	first, second := NewChangeFilter(), NewChangeFilter()
+	publisher := &countingPublisher{}
+
+	assert.Nil(t, first.Deliver(publisher, member, event(20)))
+	assert.Nil(t, second.Deliver(publisher, member, event(20)))
+	assert.Nil(t, second.Deliver(publisher, member, event(21)))
+	assert.Nil(t, first.Deliver(publisher, member, event(21)))
+	assert.Equal(t, 2, publisher.published)
+
+	// A failed publish leaves the last values as they were.
+	assert.NotNil(t, first.Deliver(&countingPublisher{err: errors.New("unavailable")}, member, event(22)))
+	assert.Nil(t, second.Deliver(publisher, member, event(22)))
+	assert.Equal(t, 3, publisher.published)
+}
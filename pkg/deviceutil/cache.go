@@ -0,0 +1,169 @@
+package deviceutil
+
+import (
+	"container/list"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tkeel-io/core-broker/pkg/metrics"
+)
+
+// _metadataProperties are the properties of an entity its metadata is read
+// from.
+var _metadataProperties = []string{"basicInfo", "connectInfo"}
+
+// CacheScope is the scope of the metadata looked up by a user, the device
+// service only returns the entities the user is allowed to see.
+func CacheScope(tenantID, userID string) string {
+	return tenantID + "/" + userID
+}
+
+// EntityMetadata is the cached metadata of an entity.
+type EntityMetadata struct {
+	ID         string
+	Name       string
+	TemplateID string
+	Template   string
+	Group      string
+	Online     bool
+	UpdatedAt  int64
+}
+
+// NewEntityMetadata extracts the metadata from a search result item.
+func NewEntityMetadata(item *Object) EntityMetadata {
+	return EntityMetadata{
+		ID:         item.Id,
+		Name:       item.Properties.BasicInfo.Name,
+		TemplateID: item.Properties.BasicInfo.TemplateID,
+		Template:   item.Properties.BasicInfo.TemplateName,
+		Group:      item.Properties.BasicInfo.ParentName,
+		Online:     item.Properties.ConnectionInfo.IsOnline,
+		UpdatedAt:  item.Properties.SysField.UpdatedAt,
+	}
+}
+
+type cacheEntry struct {
+	scope     string
+	metadata  EntityMetadata
+	expiresAt time.Time
+}
+
+// EntityCache is a size bounded LRU cache of entity metadata whose entries
+// expire after the TTL. The entries are kept per scope, see CacheScope, and
+// are removed when a change of the entity is received.
+type EntityCache struct {
+	lock sync.Mutex
+	size int
+	ttl  time.Duration
+	// order holds the entries of all the scopes, entries indexes them by
+	// entity ID and scope.
+	order   *list.List
+	entries map[string]map[string]*list.Element
+	now     func() time.Time
+}
+
+func NewEntityCache(size int, ttl time.Duration) *EntityCache {
+	return &EntityCache{
+		size:    size,
+		ttl:     ttl,
+		order:   list.New(),
+		entries: make(map[string]map[string]*list.Element),
+		now:     time.Now,
+	}
+}
+
+// Get returns the metadata of the entity cached in the scope if it is not
+// expired.
+func (c *EntityCache) Get(scope, id string) (EntityMetadata, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if element, ok := c.entries[id][scope]; ok {
+		entry := element.Value.(*cacheEntry)
+		if c.now().Before(entry.expiresAt) {
+			c.order.MoveToFront(element)
+			metrics.CollectorDeviceCacheHits.Inc()
+			return entry.metadata, true
+		}
+		c.remove(element)
+	}
+	metrics.CollectorDeviceCacheMisses.Inc()
+	return EntityMetadata{}, false
+}
+
+// Add caches the metadata in the scope, evicting the least recently used
+// entry when the cache is full.
+func (c *EntityCache) Add(scope string, metadata EntityMetadata) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	entry := &cacheEntry{scope: scope, metadata: metadata, expiresAt: c.now().Add(c.ttl)}
+	if element, ok := c.entries[metadata.ID][scope]; ok {
+		element.Value = entry
+		c.order.MoveToFront(element)
+		return
+	}
+	scopes, ok := c.entries[metadata.ID]
+	if !ok {
+		scopes = make(map[string]*list.Element)
+		c.entries[metadata.ID] = scopes
+	}
+	scopes[scope] = c.order.PushFront(entry)
+	for c.order.Len() > c.size {
+		c.remove(c.order.Back())
+	}
+}
+
+// Remove invalidates the cached metadata of the entity in every scope.
+func (c *EntityCache) Remove(id string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for _, element := range c.entries[id] {
+		c.remove(element)
+	}
+}
+
+// Invalidate removes the cached metadata of the entity if the changed
+// properties include one of its metadata properties.
+func (c *EntityCache) Invalidate(id string, properties map[string]interface{}) {
+	for key := range properties {
+		for _, prefix := range _metadataProperties {
+			if strings.HasPrefix(key, prefix) {
+				c.Remove(id)
+				return
+			}
+		}
+	}
+}
+
+// Configure changes the size and the TTL of the cache, the entries beyond
+// the size are evicted and the others expire with the new TTL.
+func (c *EntityCache) Configure(size int, ttl time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.size, c.ttl = size, ttl
+	for element := c.order.Front(); element != nil; element = element.Next() {
+		entry := element.Value.(*cacheEntry)
+		if expiresAt := c.now().Add(ttl); expiresAt.Before(entry.expiresAt) {
+			entry.expiresAt = expiresAt
+		}
+	}
+	for c.order.Len() > c.size {
+		c.remove(c.order.Back())
+	}
+}
+
+func (c *EntityCache) Len() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.order.Len()
+}
+
+func (c *EntityCache) remove(element *list.Element) {
+	c.order.Remove(element)
+	entry := element.Value.(*cacheEntry)
+	scopes := c.entries[entry.metadata.ID]
+	delete(scopes, entry.scope)
+	if len(scopes) == 0 {
+		delete(c.entries, entry.metadata.ID)
+	}
+}
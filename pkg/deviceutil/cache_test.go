@@ -0,0 +1,77 @@
+package deviceutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntityCache(t *testing.T) {
+	now := time.Unix(1650000000, 0)
+	cache := NewEntityCache(2, time.Minute)
+	cache.now = func() time.Time { return now }
+	scope := CacheScope("tenant-1", "usr-1")
+
+	cache.Add(scope, EntityMetadata{ID: "a", Name: "A"})
+	cache.Add(scope, EntityMetadata{ID: "b", Name: "B"})
+	metadata, ok := cache.Get(scope, "a")
+	assert.True(t, ok)
+	assert.Equal(t, "A", metadata.Name)
+
+	// b is the least recently used one.
+	cache.Add(scope, EntityMetadata{ID: "c", Name: "C"})
+	_, ok = cache.Get(scope, "b")
+	assert.False(t, ok)
+	assert.Equal(t, 2, cache.Len())
+
+	cache.Remove("a")
+	_, ok = cache.Get(scope, "a")
+	assert.False(t, ok)
+
+	now = now.Add(time.Minute)
+	_, ok = cache.Get(scope, "c")
+	assert.False(t, ok)
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestEntityCacheScopes(t *testing.T) {
+	cache := NewEntityCache(10, time.Minute)
+	tenant1, tenant2 := CacheScope("tenant-1", "usr-1"), CacheScope("tenant-2", "usr-1")
+
+	cache.Add(tenant1, EntityMetadata{ID: "a", Name: "A"})
+	_, ok := cache.Get(tenant2, "a")
+	assert.False(t, ok)
+
+	cache.Add(tenant2, EntityMetadata{ID: "a", Name: "A2"})
+	metadata, ok := cache.Get(tenant1, "a")
+	assert.True(t, ok)
+	assert.Equal(t, "A", metadata.Name)
+	assert.Equal(t, 2, cache.Len())
+
+	cache.Remove("a")
+	_, ok = cache.Get(tenant1, "a")
+	assert.False(t, ok)
+	_, ok = cache.Get(tenant2, "a")
+	assert.False(t, ok)
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestEntityCacheInvalidate(t *testing.T) {
+	cache := NewEntityCache(10, time.Minute)
+	scope := CacheScope("tenant-1", "usr-1")
+	cache.Add(scope, EntityMetadata{ID: "a"})
+	cache.Add(scope, EntityMetadata{ID: "b"})
+
+	cache.Invalidate("a", map[string]interface{}{"telemetry": map[string]interface{}{"temp": 1}})
+	_, ok := cache.Get(scope, "a")
+	assert.True(t, ok)
+
+	cache.Invalidate("b", map[string]interface{}{"connectInfo._online": true})
+	_, ok = cache.Get(scope, "b")
+	assert.False(t, ok)
+}
+
+func TestInQuery(t *testing.T) {
+	assert.Equal(t, ConditionQuery{Field: "id", Operator: "$in", Value: "a,b,c"}, InQuery("id", "a", "b", "c"))
+}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/tkeel-io/core-broker/pkg/config"
 	"github.com/tkeel-io/kit/log"
 )
 
@@ -17,11 +18,30 @@ func (s Service) String() string {
 	return string(s)
 }
 
-const (
+var (
 	DeviceSearch Service = "http://localhost:3500/v1.0/invoke/keel/method/apis/tkeel-device/v1/search"
 	EntitySearch Service = "http://localhost:3500/v1.0/invoke/keel/method/apis/core/v1/entities/search"
 )
 
+// Services are the searches of the platform behind the dapr sidecar.
+type Services struct {
+	DeviceSearch Service
+	EntitySearch Service
+	// CoreEntitySearch searches core directly, authorized by the app
+	// identity of the broker like its other invocations of core.
+	CoreEntitySearch Service
+}
+
+// NewServices points the searches at the keel and core apps of the dapr
+// settings.
+func NewServices(dapr config.Dapr) Services {
+	return Services{
+		DeviceSearch:     Service(dapr.InvokeURL(dapr.KeelAppID, "apis/tkeel-device/v1/search")),
+		EntitySearch:     Service(dapr.InvokeURL(dapr.KeelAppID, "apis/core/v1/entities/search")),
+		CoreEntitySearch: Service(dapr.InvokeURL(dapr.CoreAppID, "v1/entities/search?owner=admin&source=dm")),
+	}
+}
+
 type Client struct {
 	http  *http.Client
 	token string
@@ -36,6 +56,12 @@ func NewClient(token, auth string) *Client {
 	}
 }
 
+// NewServiceClient searches without user credentials, for
+// Services.CoreEntitySearch.
+func NewServiceClient() *Client {
+	return NewClient("", "")
+}
+
 type RequestOption func(*SearchRequest) error
 
 func WithPagination(page, size int32) RequestOption {
@@ -56,6 +82,14 @@ func WithQuery(query string) RequestOption {
 	}
 }
 
+func WithOrder(orderBy string, isDescending bool) RequestOption {
+	return func(req *SearchRequest) error {
+		req.OrderBy = orderBy
+		req.IsDescending = isDescending
+		return nil
+	}
+}
+
 func (c Client) Search(url Service, conditions Conditions, options ...RequestOption) ([]byte, error) {
 	searchRequest := SearchRequest{
 		PageNum:    1,
@@ -186,3 +220,28 @@ func WildcardQuery(field, value string) ConditionQuery {
 		Value:    value,
 	}
 }
+
+func GteQuery(field, value string) ConditionQuery {
+	return ConditionQuery{
+		Field:    field,
+		Operator: "$gte",
+		Value:    value,
+	}
+}
+
+func LteQuery(field, value string) ConditionQuery {
+	return ConditionQuery{
+		Field:    field,
+		Operator: "$lte",
+		Value:    value,
+	}
+}
+
+// InQuery matches the entities whose field equals one of the values.
+func InQuery(field string, values ...string) ConditionQuery {
+	return ConditionQuery{
+		Field:    field,
+		Operator: "$in",
+		Value:    strings.Join(values, ","),
+	}
+}
@@ -4,7 +4,10 @@ import "github.com/prometheus/client_golang/prometheus"
 
 const (
 	// metrics label.
-	MetricsLabelTenant = "tenant_id"
+	MetricsLabelTenant   = "tenant_id"
+	MetricsLabelMethod   = "method"
+	MetricsLabelResult   = "result"
+	MetricsLabelInstance = "instance"
 
 	// metrics subscribe name.
 	MetricsNameSubNum = "subscribe_num"
@@ -17,6 +20,45 @@ const (
 
 	// metrics subscribe entity name.
 	MetricsNameSubEntitiesMax = "subscribe_entities_max"
+
+	// metrics delivery late events name.
+	MetricsNameDeliveryLateEvents = "delivery_late_events"
+
+	// metrics delivery windows name.
+	MetricsNameDeliveryWindows = "delivery_windows"
+
+	// metrics delivery suppressed events name.
+	MetricsNameDeliverySuppressed = "delivery_suppressed_events"
+
+	// metrics device cache hits name.
+	MetricsNameDeviceCacheHits = "device_cache_hits"
+
+	// metrics device cache misses name.
+	MetricsNameDeviceCacheMisses = "device_cache_misses"
+
+	// metrics core invocations name.
+	MetricsNameCoreInvocations = "core_invocations"
+
+	// metrics core invocation retries name.
+	MetricsNameCoreRetries = "core_invocation_retries"
+
+	// metrics core invocation duration name.
+	MetricsNameCoreDuration = "core_invocation_duration_seconds"
+
+	// metrics core circuit breaker state name, 0 closed, 1 half open and 2 open.
+	MetricsNameCoreCircuitState = "core_circuit_state"
+
+	// metrics ws client queue length name.
+	MetricsNameWsQueueLength = "ws_client_queue_length"
+
+	// metrics ws client lag name.
+	MetricsNameWsLag = "ws_client_lag_seconds"
+
+	// metrics ws client dropped messages name.
+	MetricsNameWsDropped = "ws_client_dropped_messages"
+
+	// metrics ws core subscriptions name.
+	MetricsNameWsCoreSubscriptions = "ws_core_subscriptions"
 )
 
 var CollectorSubscribeMax = prometheus.NewGaugeVec(
@@ -50,4 +92,107 @@ var CollectorSubscribeEntitiesNum = prometheus.NewGaugeVec(
 	},
 	[]string{MetricsLabelTenant},
 )
-var Metrics = []prometheus.Collector{CollectorSubscribeEntitiesNum, CollectorSubscribeNum}
+
+var CollectorDeliveryLateEvents = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: MetricsNameDeliveryLateEvents,
+		Help: "delivery late events.",
+	},
+	[]string{MetricsLabelTenant},
+)
+
+var CollectorDeliveryWindows = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: MetricsNameDeliveryWindows,
+		Help: "delivery windows.",
+	},
+	[]string{MetricsLabelTenant},
+)
+
+var CollectorDeliverySuppressed = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: MetricsNameDeliverySuppressed,
+		Help: "delivery suppressed events.",
+	},
+	[]string{MetricsLabelTenant},
+)
+
+var CollectorDeviceCacheHits = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Name: MetricsNameDeviceCacheHits,
+		Help: "device cache hits.",
+	},
+)
+
+var CollectorDeviceCacheMisses = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Name: MetricsNameDeviceCacheMisses,
+		Help: "device cache misses.",
+	},
+)
+
+var CollectorCoreInvocations = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: MetricsNameCoreInvocations,
+		Help: "core invocations by result, success, failure or rejected.",
+	},
+	[]string{MetricsLabelMethod, MetricsLabelResult},
+)
+
+var CollectorCoreRetries = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: MetricsNameCoreRetries,
+		Help: "core invocation retries.",
+	},
+	[]string{MetricsLabelMethod},
+)
+
+var CollectorCoreDuration = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name: MetricsNameCoreDuration,
+		Help: "core invocation attempt duration.",
+	},
+	[]string{MetricsLabelMethod},
+)
+
+var CollectorCoreCircuitState = prometheus.NewGauge(
+	prometheus.GaugeOpts{
+		Name: MetricsNameCoreCircuitState,
+		Help: "core circuit breaker state, 0 closed, 1 half open and 2 open.",
+	},
+)
+
+var CollectorWsQueueLength = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name:    MetricsNameWsQueueLength,
+		Help:    "ws client messages waiting to be written, observed on every queued and written message.",
+		Buckets: prometheus.ExponentialBuckets(1, 4, 6),
+	},
+	[]string{MetricsLabelInstance},
+)
+
+var CollectorWsLag = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name: MetricsNameWsLag,
+		Help: "ws client time a written message waited in the queue.",
+	},
+	[]string{MetricsLabelInstance},
+)
+
+var CollectorWsDropped = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: MetricsNameWsDropped,
+		Help: "ws client messages dropped by the overflow policy.",
+	},
+	[]string{MetricsLabelInstance},
+)
+
+var CollectorWsCoreSubscriptions = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: MetricsNameWsCoreSubscriptions,
+		Help: "ws core subscriptions by broker instance.",
+	},
+	[]string{MetricsLabelInstance},
+)
+
+var Metrics = []prometheus.Collector{CollectorSubscribeEntitiesNum, CollectorSubscribeNum, CollectorDeliveryLateEvents, CollectorDeliveryWindows, CollectorDeliverySuppressed, CollectorDeviceCacheHits, CollectorDeviceCacheMisses, CollectorCoreInvocations, CollectorCoreRetries, CollectorCoreDuration, CollectorCoreCircuitState, CollectorWsQueueLength, CollectorWsLag, CollectorWsDropped, CollectorWsCoreSubscriptions}
@@ -0,0 +1,75 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/tkeel-io/kit/log"
+	"gorm.io/gorm"
+)
+
+// actions of the audit entries.
+const (
+	AuditEntityDeleted = "entity_deleted"
+)
+
+// Audit records a change the broker made on its own, without a user
+// request behind it.
+type Audit struct {
+	ID          uint      `gorm:"primarykey"`
+	CreatedAt   time.Time `gorm:"index"`
+	Action      string    `gorm:"size:64;index"`
+	EntityID    string    `gorm:"size:255;index"`
+	SubscribeID uint
+	UserID      string
+	Message     string `gorm:"type:text"`
+}
+
+func RecordAudit(audit *Audit) error {
+	return DB().Create(audit).Error
+}
+
+// RemoveDeletedEntity removes the memberships and the core subscriptions of
+// an entity deleted in the platform, one audit entry is recorded per removed
+// membership. Running it again for the same entity does nothing.
+func RemoveDeletedEntity(ctx context.Context, entityID string) (int, error) {
+	memberships := make([]SubscribeEntities, 0)
+	if err := DB().Preload("Subscribe").
+		Where("entity_id = ?", entityID).
+		Find(&memberships).Error; err != nil {
+		return 0, err
+	}
+	// The entity is gone, so is its _subscribeAddr, only the memberships and
+	// the core subscriptions are left to remove.
+	noHooks := DB().Session(&gorm.Session{SkipHooks: true})
+	for i := range memberships {
+		subscribe := &memberships[i].Subscribe
+		audit := &Audit{
+			Action:      AuditEntityDeleted,
+			EntityID:    entityID,
+			SubscribeID: memberships[i].SubscribeID,
+			UserID:      subscribe.UserID,
+			Message:     "membership and core subscription removed",
+		}
+		if err := removeCoreSubscription(ctx, entityID, subscribe); err != nil {
+			// core may have dropped the subscriptions of the deleted entity
+			// itself, the membership is removed regardless.
+			log.Errorf("delete core subscription of deleted entity %s err: %v", entityID, err)
+			audit.Message = "membership removed, delete core subscription err: " + err.Error()
+		}
+		if err := noHooks.Where("subscribe_id = ?", memberships[i].SubscribeID).
+			Where("entity_id = ?", entityID).
+			Delete(&SubscribeEntities{}).Error; err != nil {
+			return i, err
+		}
+		if err := noHooks.Where("subscribe_id = ?", memberships[i].SubscribeID).
+			Where("entity_id = ?", entityID).
+			Delete(&SubscribeLastValue{}).Error; err != nil {
+			log.Errorf("delete last values of deleted entity %s err: %v", entityID, err)
+		}
+		if err := RecordAudit(audit); err != nil {
+			log.Errorf("record audit of deleted entity %s err: %v", entityID, err)
+		}
+	}
+	return len(memberships), nil
+}
@@ -0,0 +1,67 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/core-broker/pkg/config"
+	"github.com/tkeel-io/core-broker/pkg/core/fake"
+)
+
+func TestRemoveDeletedEntity(t *testing.T) {
+	openSQLite(t)
+	fakeCore := setupFakeCore(t)
+	for _, id := range []string{"iotd-1", "iotd-2"} {
+		fakeCore.AddEntity(id, "usr-1", nil)
+	}
+	dashboard := Subscribe{Title: "dashboard", UserID: "usr-1", Mode: ModeRealtime, Endpoint: "dashboard"}
+	assert.Nil(t, DB().Create(&dashboard).Error)
+	alarms := Subscribe{Title: "alarms", UserID: "usr-1", Mode: ModeOnChange, Endpoint: "alarms"}
+	assert.Nil(t, DB().Create(&alarms).Error)
+	for _, subscribe := range []*Subscribe{&dashboard, &alarms} {
+		_, err := SubscribeEntitiesBulk(context.Background(), config.Default().BulkSubscribe, subscribe, []string{"iotd-1", "iotd-2"})
+		assert.Nil(t, err)
+	}
+	_, err := SwapLastValues(alarms.ID, "iotd-1", 0, map[string]interface{}{"temp": 1})
+	assert.Nil(t, err)
+	assert.Len(t, fakeCore.Subscriptions("iotd-1"), 2)
+
+	// core has already dropped one of the subscriptions, the membership is
+	// removed anyway.
+	fakeCore.SetError(fake.MethodUnsubscribe, errors.New("subscription not found"))
+	removed, err := RemoveDeletedEntity(context.Background(), "iotd-1")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, removed)
+	fakeCore.SetError(fake.MethodUnsubscribe, nil)
+
+	for _, subscribe := range []*Subscribe{&dashboard, &alarms} {
+		ids, err := subscribe.EntityIDs()
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"iotd-2"}, ids)
+	}
+	values, _, err := LastValues(alarms.ID, "iotd-1")
+	assert.Nil(t, err)
+	assert.Empty(t, values)
+	assert.Len(t, fakeCore.Subscriptions("iotd-2"), 2)
+
+	audits := make([]Audit, 0)
+	assert.Nil(t, DB().Order("subscribe_id").Find(&audits).Error)
+	assert.Len(t, audits, 2)
+	for i, subscribe := range []*Subscribe{&dashboard, &alarms} {
+		assert.Equal(t, AuditEntityDeleted, audits[i].Action)
+		assert.Equal(t, "iotd-1", audits[i].EntityID)
+		assert.Equal(t, subscribe.ID, audits[i].SubscribeID)
+		assert.Equal(t, "usr-1", audits[i].UserID)
+		assert.Contains(t, audits[i].Message, "subscription not found")
+	}
+
+	// The event is delivered again.
+	removed, err = RemoveDeletedEntity(context.Background(), "iotd-1")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, removed)
+	var count int64
+	assert.Nil(t, DB().Model(&Audit{}).Count(&count).Error)
+	assert.Equal(t, int64(2), count)
+}
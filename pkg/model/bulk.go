@@ -0,0 +1,282 @@
+package model
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core-broker/pkg/config"
+	"github.com/tkeel-io/core-broker/pkg/subscribeuril"
+	"github.com/tkeel-io/kit/log"
+	"gorm.io/gorm"
+)
+
+// _pendingMembershipTimeout is how long a membership may be pending before it
+// is taken for one left by a stopped broker. A setup takes a few invocations
+// of core, even the last member of a large bulk is set up well within it.
+const _pendingMembershipTimeout = 30 * time.Minute
+
+// BulkResult is the result of adding an entity to a subscribe.
+type BulkResult struct {
+	EntityID string
+	// Duplicate is set if the entity already was a member of the subscribe.
+	Duplicate bool
+	Err       error
+}
+
+// SubscribeEntitiesBulk adds the entities to the subscribe. The memberships
+// are inserted in batches without hooks and marked pending, then a pool of
+// workers creates the core subscription and adds the _subscribeAddr entry of
+// each new member, the way the AfterCreate hook does for a single one, and
+// confirms it. The membership of an entity whose setup fails is removed
+// again, the ones left pending by a broker stopped meanwhile are removed by
+// RollbackPendingMemberships. The results follow the order of
+// entityIDs, an ID given twice is reported once. Entities which the query of
+// the subscribe added before are managed by hand from now on. The batch size
+// and the number of workers are taken from opts.
+func SubscribeEntitiesBulk(ctx context.Context, opts config.BulkSubscribe, subscribe *Subscribe, entityIDs []string) ([]BulkResult, error) {
+	return subscribeEntitiesBulk(ctx, opts, subscribe, entityIDs, false)
+}
+
+// SubscribeQueryEntitiesBulk is SubscribeEntitiesBulk for the entities
+// matched by the query of the subscribe, which removes them once it no longer
+// matches them.
+func SubscribeQueryEntitiesBulk(ctx context.Context, opts config.BulkSubscribe, subscribe *Subscribe, entityIDs []string) ([]BulkResult, error) {
+	return subscribeEntitiesBulk(ctx, opts, subscribe, entityIDs, true)
+}
+
+func subscribeEntitiesBulk(ctx context.Context, opts config.BulkSubscribe, subscribe *Subscribe, entityIDs []string, fromQuery bool) ([]BulkResult, error) {
+	entityIDs = uniqueStrings(entityIDs)
+	results := make([]BulkResult, len(entityIDs))
+	for i, id := range entityIDs {
+		results[i].EntityID = id
+	}
+	created, err := insertMemberships(ctx, opts.BatchSize, subscribe, results, fromQuery)
+	if err != nil {
+		return nil, err
+	}
+	if !fromQuery {
+		if err = claimQueryMemberships(ctx, opts.BatchSize, subscribe, results); err != nil {
+			return nil, err
+		}
+	}
+	if len(created) == 0 {
+		return results, nil
+	}
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < opts.Concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i].Err = setupMembership(ctx, subscribe, results[i].EntityID)
+			}
+		}()
+	}
+	for _, i := range created {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	return results, nil
+}
+
+// insertMemberships inserts the memberships of the results which are not
+// duplicates and returns their indexes.
+func insertMemberships(ctx context.Context, batchSize int, subscribe *Subscribe, results []BulkResult, fromQuery bool) ([]int, error) {
+	noHooks := DB().WithContext(ctx).Session(&gorm.Session{SkipHooks: true})
+	now := time.Now()
+	created := make([]int, 0, len(results))
+	for start := 0; start < len(results); start += batchSize {
+		end := start + batchSize
+		if end > len(results) {
+			end = len(results)
+		}
+		ids := make([]string, 0, end-start)
+		for i := start; i < end; i++ {
+			ids = append(ids, results[i].EntityID)
+		}
+		existing := make([]string, 0)
+		if err := noHooks.Model(&SubscribeEntities{}).
+			Where("subscribe_id = ?", subscribe.ID).
+			Where("entity_id IN ?", ids).
+			Pluck("entity_id", &existing).Error; err != nil {
+			return nil, errors.Wrap(err, "list existing memberships")
+		}
+		members := make(map[string]struct{}, len(existing))
+		for _, id := range existing {
+			members[id] = struct{}{}
+		}
+
+		indexes := make([]int, 0, end-start)
+		records := make([]SubscribeEntities, 0, end-start)
+		for i := start; i < end; i++ {
+			if _, ok := members[results[i].EntityID]; ok {
+				results[i].Duplicate = true
+				continue
+			}
+			indexes = append(indexes, i)
+			record := newMembership(subscribe, results[i].EntityID)
+			record.FromQuery = fromQuery
+			record.PendingSince = &now
+			records = append(records, record)
+		}
+		if len(records) == 0 {
+			continue
+		}
+		err := noHooks.Create(&records).Error
+		if err == nil {
+			created = append(created, indexes...)
+			continue
+		}
+		if !IsDuplicateKey(err) {
+			return nil, errors.Wrap(err, "insert memberships")
+		}
+		// A concurrent request has added some of the entities meanwhile.
+		for j, i := range indexes {
+			if err = noHooks.Create(&records[j]).Error; err != nil {
+				if !IsDuplicateKey(err) {
+					return nil, errors.Wrap(err, "insert membership")
+				}
+				results[i].Duplicate = true
+				continue
+			}
+			created = append(created, i)
+		}
+	}
+	return created, nil
+}
+
+// claimQueryMemberships marks the duplicate results as added by hand.
+func claimQueryMemberships(ctx context.Context, batchSize int, subscribe *Subscribe, results []BulkResult) error {
+	ids := make([]string, 0)
+	for _, r := range results {
+		if r.Duplicate {
+			ids = append(ids, r.EntityID)
+		}
+	}
+	for start := 0; start < len(ids); start += batchSize {
+		end := start + batchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		if err := DB().WithContext(ctx).Session(&gorm.Session{SkipHooks: true}).
+			Model(&SubscribeEntities{}).
+			Where("subscribe_id = ?", subscribe.ID).
+			Where("entity_id IN ?", ids[start:end]).
+			Where("from_query = ?", true).
+			Update("from_query", false).Error; err != nil {
+			return errors.Wrap(err, "claim query memberships")
+		}
+	}
+	return nil
+}
+
+// setupMembership creates the core subscription and the _subscribeAddr entry
+// of a new member, it removes the membership if one of them fails.
+func setupMembership(ctx context.Context, subscribe *Subscribe, entityID string) error {
+	err := createCoreSubscription(ctx, entityID, subscribe)
+	if err != nil {
+		err = errors.Wrap(err, "create core subscription err")
+	}
+	if err == nil {
+		if err = updateEntitySubscribeEndpoint(ctx, entityID, subscribeAddress(subscribe), Add); err != nil {
+			err = errors.Wrap(err, "update entity subscribe endpoint err")
+			if undoErr := removeCoreSubscription(context.Background(), entityID, subscribe); undoErr != nil {
+				log.Errorf("delete core subscription of entity %s err: %v", entityID, undoErr)
+			}
+		}
+	}
+	if err == nil {
+		if err = confirmMembership(ctx, subscribe, entityID); err == nil {
+			return nil
+		}
+		rollbackMembership(subscribe, entityID)
+		return errors.Wrap(err, "confirm membership err")
+	}
+	deleteMembership(subscribe, entityID)
+	return err
+}
+
+// confirmMembership clears the pending mark of a membership once it is set
+// up, it fails if the membership has been rolled back meanwhile.
+func confirmMembership(ctx context.Context, subscribe *Subscribe, entityID string) error {
+	res := DB().WithContext(ctx).Session(&gorm.Session{SkipHooks: true}).
+		Model(&SubscribeEntities{}).
+		Where("subscribe_id = ?", subscribe.ID).
+		Where("entity_id = ?", entityID).
+		Update("pending_since", nil)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return errors.New("membership has been rolled back")
+	}
+	return nil
+}
+
+// rollbackMembership removes the core subscription, the _subscribeAddr entry
+// and the membership of a pending member, whichever of them exist.
+func rollbackMembership(subscribe *Subscribe, entityID string) {
+	ctx := context.Background()
+	if err := removeCoreSubscription(ctx, entityID, subscribe); err != nil {
+		log.Errorf("delete core subscription of entity %s err: %v", entityID, err)
+	}
+	if err := updateEntitySubscribeEndpoint(ctx, entityID, subscribeAddress(subscribe), Reduce); err != nil {
+		log.Errorf("update entity %s subscribe endpoint err: %v", entityID, err)
+	}
+	deleteMembership(subscribe, entityID)
+}
+
+// RollbackPendingMemberships removes the memberships left pending for longer
+// than _pendingMembershipTimeout by a broker stopped while adding entities in
+// bulk, the delivery processor runs it periodically. Their setup may have
+// been done in part, so it is undone and the entities have to be added again.
+func RollbackPendingMemberships(ctx context.Context) error {
+	pending := make([]SubscribeEntities, 0)
+	if err := DB().WithContext(ctx).
+		Preload("Subscribe", func(tx *gorm.DB) *gorm.DB { return tx.Unscoped() }).
+		Where("pending_since <= ?", time.Now().Add(-_pendingMembershipTimeout)).
+		Find(&pending).Error; err != nil {
+		return errors.Wrap(err, "list pending memberships")
+	}
+	for i := range pending {
+		log.Warnf("roll back pending membership of entity %s in subscribe %d", pending[i].EntityID, pending[i].SubscribeID)
+		rollbackMembership(&pending[i].Subscribe, pending[i].EntityID)
+	}
+	return nil
+}
+
+// deleteMembership removes the membership without hooks. The caller may have
+// given up, so it does not use its context.
+func deleteMembership(subscribe *Subscribe, entityID string) {
+	if deleteErr := DB().Session(&gorm.Session{SkipHooks: true}).
+		Where("subscribe_id = ?", subscribe.ID).
+		Where("entity_id = ?", entityID).
+		Delete(&SubscribeEntities{}).Error; deleteErr != nil {
+		log.Errorf("delete membership of entity %s err: %v", entityID, deleteErr)
+	}
+}
+
+func newMembership(subscribe *Subscribe, entityID string) SubscribeEntities {
+	return SubscribeEntities{
+		EntityID:    entityID,
+		SubscribeID: subscribe.ID,
+		UniqueKey:   subscribeuril.GenerateSubscribeTopic(subscribe.ID, entityID),
+	}
+}
+
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]struct{}, len(values))
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		out = append(out, v)
+	}
+	return out
+}
@@ -0,0 +1,145 @@
+package model
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/core-broker/pkg/config"
+	"github.com/tkeel-io/core-broker/pkg/core/fake"
+	"github.com/tkeel-io/core-broker/pkg/subscribeuril"
+	"github.com/tkeel-io/core-broker/pkg/types"
+	"gorm.io/gorm"
+)
+
+func setupFakeCore(t testing.TB) *fake.Core {
+	fakeCore := fake.New()
+	coreClient = fakeCore
+	t.Cleanup(func() { coreClient = nil })
+	return fakeCore
+}
+
+func TestSubscribeEntitiesBulk(t *testing.T) {
+	openSQLite(t)
+	fakeCore := setupFakeCore(t)
+	for _, id := range []string{"iotd-1", "iotd-2", "iotd-3"} {
+		fakeCore.AddEntity(id, "usr-1", nil)
+	}
+	subscribe := Subscribe{Title: "dashboard", UserID: "usr-1", Mode: ModeRealtime}
+	assert.Nil(t, DB().Create(&subscribe).Error)
+	assert.Nil(t, DB().Create(&SubscribeEntities{
+		EntityID:    "iotd-1",
+		SubscribeID: subscribe.ID,
+		UniqueKey:   subscribeuril.GenerateSubscribeTopic(subscribe.ID, "iotd-1"),
+	}).Error)
+
+	results, err := SubscribeEntitiesBulk(context.Background(), config.Default().BulkSubscribe, &subscribe, []string{"iotd-1", "iotd-2", "iotd-missing", "iotd-3", "iotd-2"})
+	assert.Nil(t, err)
+	assert.Len(t, results, 4)
+	assert.Equal(t, BulkResult{EntityID: "iotd-1", Duplicate: true}, results[0])
+	assert.Equal(t, BulkResult{EntityID: "iotd-2"}, results[1])
+	assert.Equal(t, "iotd-missing", results[2].EntityID)
+	assert.NotNil(t, results[2].Err)
+	assert.Equal(t, BulkResult{EntityID: "iotd-3"}, results[3])
+
+	ids, err := subscribe.EntityIDs()
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"iotd-1", "iotd-2", "iotd-3"}, ids)
+	assert.Empty(t, fakeCore.Subscriptions("iotd-missing"))
+	var pending int64
+	assert.Nil(t, DB().Model(&SubscribeEntities{}).Where("pending_since IS NOT NULL").Count(&pending).Error)
+	assert.Equal(t, int64(0), pending)
+	for _, id := range ids {
+		assert.Len(t, fakeCore.Subscriptions(id), 1)
+		value, _ := fakeCore.Property(id, "sysField._subscribeAddr")
+		addrs, err := subscribeuril.ParseSubscribeAddrs(types.Interface2string(value))
+		assert.Nil(t, err)
+		assert.Equal(t, subscribeuril.SubscribeAddrs{subscribeAddress(&subscribe)}, addrs)
+	}
+}
+
+func TestRollbackPendingMemberships(t *testing.T) {
+	openSQLite(t)
+	fakeCore := setupFakeCore(t)
+	for _, id := range []string{"iotd-1", "iotd-2"} {
+		fakeCore.AddEntity(id, "usr-1", nil)
+	}
+	subscribe := Subscribe{Title: "dashboard", UserID: "usr-1", Mode: ModeRealtime}
+	assert.Nil(t, DB().Create(&subscribe).Error)
+	_, err := SubscribeEntitiesBulk(context.Background(), config.Default().BulkSubscribe, &subscribe, []string{"iotd-1", "iotd-2"})
+	assert.Nil(t, err)
+
+	// A broker stopped after setting up iotd-1 but before confirming it,
+	// iotd-2 is still being set up by another one.
+	stale := time.Now().Add(-_pendingMembershipTimeout - time.Minute)
+	recent := time.Now()
+	noHooks := DB().Session(&gorm.Session{SkipHooks: true})
+	assert.Nil(t, noHooks.Model(&SubscribeEntities{}).Where("entity_id = ?", "iotd-1").Update("pending_since", &stale).Error)
+	assert.Nil(t, noHooks.Model(&SubscribeEntities{}).Where("entity_id = ?", "iotd-2").Update("pending_since", &recent).Error)
+
+	assert.Nil(t, RollbackPendingMemberships(context.Background()))
+	ids, err := subscribe.EntityIDs()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"iotd-2"}, ids)
+	assert.Empty(t, fakeCore.Subscriptions("iotd-1"))
+	value, _ := fakeCore.Property("iotd-1", "sysField._subscribeAddr")
+	assert.Equal(t, "", value)
+	assert.Len(t, fakeCore.Subscriptions("iotd-2"), 1)
+
+	// A setup still running when its membership was rolled back is not
+	// confirmed.
+	assert.NotNil(t, confirmMembership(context.Background(), &subscribe, "iotd-1"))
+	assert.Nil(t, confirmMembership(context.Background(), &subscribe, "iotd-2"))
+}
+
+// benchmarkSubscribeEntities subscribes n entities of a fake core answering
+// every call after the latency of a remote core.
+func benchmarkSubscribeEntities(b *testing.B, n int, subscribeEntities func(subscribe *Subscribe, entityIDs []string)) {
+	openSQLite(b)
+	fakeCore := setupFakeCore(b)
+	for _, method := range []string{fake.MethodSubscribe, fake.MethodGetDeviceEntity, fake.MethodPatchEntity} {
+		fakeCore.SetDelay(method, time.Millisecond)
+	}
+	entityIDs := make([]string, n)
+	for i := range entityIDs {
+		entityIDs[i] = "iotd-" + strconv.Itoa(i)
+		fakeCore.AddEntity(entityIDs[i], "usr-1", nil)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		subscribe := Subscribe{Title: "bench", UserID: "usr-1", Mode: ModeRealtime}
+		if err := DB().Create(&subscribe).Error; err != nil {
+			b.Fatal(err)
+		}
+		b.StartTimer()
+		subscribeEntities(&subscribe, entityIDs)
+	}
+}
+
+func BenchmarkSubscribeEntitiesOneByOne(b *testing.B) {
+	benchmarkSubscribeEntities(b, 500, func(subscribe *Subscribe, entityIDs []string) {
+		for _, id := range entityIDs {
+			membership := newMembership(subscribe, id)
+			if err := DB().Create(&membership).Error; err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkSubscribeEntitiesBulk(b *testing.B) {
+	benchmarkSubscribeEntities(b, 500, func(subscribe *Subscribe, entityIDs []string) {
+		results, err := SubscribeEntitiesBulk(context.Background(), config.Default().BulkSubscribe, subscribe, entityIDs)
+		if err != nil {
+			b.Fatal(err)
+		}
+		for _, r := range results {
+			if r.Err != nil || r.Duplicate {
+				b.Fatal(r)
+			}
+		}
+	})
+}
@@ -0,0 +1,175 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	mysqlDriver "github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgconn"
+	"github.com/mattn/go-sqlite3"
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/kit/log"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+const (
+	DriverMySQL    = "mysql"
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite"
+)
+
+// Dialect is a database backend subscribes and their memberships are stored
+// in, it is selected by the driver of the database config.
+type Dialect interface {
+	// Dialector opens the database of the DSN.
+	Dialector(dsn string) gorm.Dialector
+	// EnsureDatabase creates the database of the DSN if it does not exist.
+	EnsureDatabase(dsn string) error
+	// Configure tunes the connection pool for the backend.
+	Configure(pool *sql.DB)
+	// IsDuplicateKey reports whether err is a unique constraint violation.
+	IsDuplicateKey(err error) bool
+	// LockMigrations blocks until it holds the migration lock of the
+	// database, shared by the broker instances, until unlock is called.
+	LockMigrations(ctx context.Context, pool *sql.DB) (unlock func(), err error)
+}
+
+// _migrationLock names the advisory lock the migrations are applied under.
+const _migrationLock = "core-broker.schema_migrations"
+
+// lockConn runs lock on a connection of its own, the advisory locks belong
+// to the session. Unlock runs unlock on it and returns it to the pool.
+func lockConn(ctx context.Context, pool *sql.DB, lock, unlock string, args ...interface{}) (func(), error) {
+	conn, err := pool.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var locked sql.NullInt64
+	if err = conn.QueryRowContext(ctx, lock, args...).Scan(&locked); err == nil && (!locked.Valid || locked.Int64 != 1) {
+		err = errors.New("lock not acquired")
+	}
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return func() {
+		if _, err := conn.ExecContext(context.Background(), unlock, args...); err != nil {
+			log.Error("release migration lock err:", err)
+		}
+		conn.Close()
+	}, nil
+}
+
+var dialects = map[string]Dialect{
+	DriverMySQL:    mysqlDialect{},
+	DriverPostgres: postgresDialect{},
+	DriverSQLite:   sqliteDialect{},
+}
+
+// DialectOf returns the dialect of the driver, MySQL if driver is empty.
+func DialectOf(driver string) (Dialect, error) {
+	if driver == "" {
+		driver = DriverMySQL
+	}
+	d, ok := dialects[driver]
+	if !ok {
+		return nil, errors.Errorf("unsupported database driver %q", driver)
+	}
+	return d, nil
+}
+
+type mysqlDialect struct{}
+
+func (mysqlDialect) Dialector(dsn string) gorm.Dialector {
+	return mysql.Open(dsn)
+}
+
+func (mysqlDialect) EnsureDatabase(dsn string) error {
+	connectionInfo, dbName := withoutDBConnectionAndDBName(dsn)
+	noDBConnection, err := gorm.Open(mysql.Open(connectionInfo), nil)
+	if err != nil {
+		return err
+	}
+	return noDBConnection.Exec(createDBSQL(dbName)).Error
+}
+
+func (mysqlDialect) Configure(*sql.DB) {}
+
+const mysqlErrDuplicateEntry = 1062
+
+func (mysqlDialect) IsDuplicateKey(err error) bool {
+	var mysqlErr *mysqlDriver.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDuplicateEntry
+}
+
+// _mysqlLockTimeout is the number of seconds an instance waits for another
+// one to finish migrating.
+const _mysqlLockTimeout = 600
+
+func (mysqlDialect) LockMigrations(ctx context.Context, pool *sql.DB) (func(), error) {
+	return lockConn(ctx, pool,
+		fmt.Sprintf("SELECT GET_LOCK(?, %d)", _mysqlLockTimeout), "DO RELEASE_LOCK(?)", _migrationLock)
+}
+
+type postgresDialect struct{}
+
+func (postgresDialect) Dialector(dsn string) gorm.Dialector {
+	return postgres.Open(dsn)
+}
+
+// EnsureDatabase does nothing, PostgreSQL has no CREATE DATABASE IF NOT
+// EXISTS and the database is expected to be provisioned with its owner.
+func (postgresDialect) EnsureDatabase(string) error {
+	return nil
+}
+
+func (postgresDialect) Configure(*sql.DB) {}
+
+const postgresUniqueViolation = "23505"
+
+func (postgresDialect) IsDuplicateKey(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == postgresUniqueViolation
+}
+
+// _postgresLockKey is the key of the advisory lock of _migrationLock.
+const _postgresLockKey = 0x6362726d6967
+
+func (postgresDialect) LockMigrations(ctx context.Context, pool *sql.DB) (func(), error) {
+	return lockConn(ctx, pool,
+		"SELECT 1 FROM (SELECT pg_advisory_lock($1)) AS locked", "SELECT pg_advisory_unlock($1)", int64(_postgresLockKey))
+}
+
+type sqliteDialect struct{}
+
+func (sqliteDialect) Dialector(dsn string) gorm.Dialector {
+	return sqlite.Open(dsn)
+}
+
+// EnsureDatabase does nothing, SQLite creates the database file on open.
+func (sqliteDialect) EnsureDatabase(string) error {
+	return nil
+}
+
+// Configure serializes the access to the database, SQLite allows a single
+// writer and an in-memory database exists once per connection.
+func (sqliteDialect) Configure(pool *sql.DB) {
+	pool.SetMaxOpenConns(1)
+}
+
+func (sqliteDialect) IsDuplicateKey(err error) bool {
+	var sqliteErr sqlite3.Error
+	return errors.As(err, &sqliteErr) &&
+		(sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique || sqliteErr.ExtendedCode == sqlite3.ErrConstraintPrimaryKey)
+}
+
+// LockMigrations takes no lock, the single connection of the pool already
+// serializes the migrations of the instance and a database file is not
+// shared by brokers on several hosts.
+func (sqliteDialect) LockMigrations(context.Context, *sql.DB) (func(), error) {
+	return func() {}, nil
+}
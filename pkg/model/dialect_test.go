@@ -0,0 +1,84 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/core-broker/pkg/config"
+	"gorm.io/gorm"
+)
+
+func openSQLite(t testing.TB) {
+	assert.Nil(t, Setup(Options{Database: config.Database{Driver: DriverSQLite, DSN: "file::memory:"}}))
+	t.Cleanup(func() {
+		pool, err := DB().DB()
+		assert.Nil(t, err)
+		pool.Close()
+		db, dialect, coreClient, amqpServer = nil, mysqlDialect{}, nil, ""
+	})
+}
+
+func TestDialectOf(t *testing.T) {
+	d, err := DialectOf("")
+	assert.Nil(t, err)
+	assert.Equal(t, mysqlDialect{}, d)
+	_, err = DialectOf("oracle")
+	assert.NotNil(t, err)
+}
+
+func TestSQLiteDuplicateKey(t *testing.T) {
+	openSQLite(t)
+	noHooks := DB().Session(&gorm.Session{SkipHooks: true})
+	entity := SubscribeEntities{EntityID: "iotd-1", SubscribeID: 1, UniqueKey: "1_iotd-1"}
+	assert.Nil(t, noHooks.Create(&entity).Error)
+	err := noHooks.Create(&SubscribeEntities{EntityID: "iotd-1", SubscribeID: 1, UniqueKey: "1_iotd-1"}).Error
+	assert.True(t, IsDuplicateKey(err))
+	assert.False(t, IsDuplicateKey(gorm.ErrRecordNotFound))
+}
+
+func TestSQLiteWindows(t *testing.T) {
+	openSQLite(t)
+	window := SubscribeWindow{SubscribeID: 1, EntityID: "iotd-1", WindowStart: 60, WindowEnd: 120, Deadline: 125}
+	assert.Nil(t, AccumulateWindow(window, map[string]PropertyStats{"temp": {Count: 1, Sum: 2, Min: 2, Max: 2}}))
+	assert.Nil(t, AccumulateWindow(window, map[string]PropertyStats{"temp": {Count: 1, Sum: 4, Min: 4, Max: 4}}))
+
+	due, err := DueWindows(125)
+	assert.Nil(t, err)
+	assert.Len(t, due, 1)
+	stats, err := due[0].Stats()
+	assert.Nil(t, err)
+	assert.Equal(t, PropertyStats{Count: 2, Sum: 6, Min: 2, Max: 4}, stats["temp"])
+
+	claimed, err := ClaimWindow(due[0].ID)
+	assert.Nil(t, err)
+	assert.True(t, claimed)
+	claimed, err = ClaimWindow(due[0].ID)
+	assert.Nil(t, err)
+	assert.False(t, claimed)
+	assert.Equal(t, ErrWindowClosed, AccumulateWindow(window, map[string]PropertyStats{"temp": {Count: 1}}))
+}
+
+func TestSQLiteLastValues(t *testing.T) {
+	openSQLite(t)
+	swapped, err := SwapLastValues(1, "iotd-1", 0, map[string]interface{}{"temp": 1.0})
+	assert.Nil(t, err)
+	assert.True(t, swapped)
+	// Another instance stored the first values meanwhile.
+	swapped, err = SwapLastValues(1, "iotd-1", 0, map[string]interface{}{"temp": 3.0})
+	assert.Nil(t, err)
+	assert.False(t, swapped)
+	swapped, err = SwapLastValues(1, "iotd-1", 1, map[string]interface{}{"temp": 2.0})
+	assert.Nil(t, err)
+	assert.True(t, swapped)
+	values, revision, err := LastValues(1, "iotd-1")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"temp": 2.0}, values)
+	assert.Equal(t, uint(2), revision)
+
+	// Neither the subscribe nor the membership exist.
+	assert.Nil(t, PurgeLastValues())
+	values, revision, err = LastValues(1, "iotd-1")
+	assert.Nil(t, err)
+	assert.Empty(t, values)
+	assert.Equal(t, uint(0), revision)
+}
@@ -0,0 +1,77 @@
+package model
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm/clause"
+)
+
+// SubscribeLastValue holds the property values last delivered to a subscribe
+// in ModeOnChange for one entity, so that duplicates stay suppressed across
+// broker restarts and instances.
+type SubscribeLastValue struct {
+	ID          uint   `gorm:"primarykey"`
+	SubscribeID uint   `gorm:"uniqueIndex:idx_subscribe_last_value;not null"`
+	EntityID    string `gorm:"uniqueIndex:idx_subscribe_last_value;size:255"`
+	Properties  string `gorm:"type:text"`
+	// Revision counts the changes of Properties, it is 0 before the first.
+	Revision uint `gorm:"not null;default:0"`
+}
+
+// LastValues returns the last delivered property values keyed by property
+// path and their revision, both are empty if nothing has been delivered yet.
+func LastValues(subscribeID uint, entityID string) (map[string]interface{}, uint, error) {
+	stored := SubscribeLastValue{}
+	res := DB().Where("subscribe_id = ?", subscribeID).
+		Where("entity_id = ?", entityID).
+		Limit(1).Find(&stored)
+	if res.Error != nil {
+		return nil, 0, res.Error
+	}
+	values := make(map[string]interface{})
+	if stored.Properties == "" {
+		return values, stored.Revision, nil
+	}
+	if err := json.Unmarshal([]byte(stored.Properties), &values); err != nil {
+		return nil, 0, errors.Wrap(err, "decode last values")
+	}
+	return values, stored.Revision, nil
+}
+
+// SwapLastValues stores the last delivered property values if they still are
+// at revision, it reports false if they have been changed since, e.g. by
+// another broker instance.
+func SwapLastValues(subscribeID uint, entityID string, revision uint, values map[string]interface{}) (bool, error) {
+	properties, err := json.Marshal(values)
+	if err != nil {
+		return false, errors.Wrap(err, "encode last values")
+	}
+	if revision == 0 {
+		res := DB().Clauses(clause.OnConflict{DoNothing: true}).Create(&SubscribeLastValue{
+			SubscribeID: subscribeID,
+			EntityID:    entityID,
+			Properties:  string(properties),
+			Revision:    1,
+		})
+		return res.RowsAffected == 1, res.Error
+	}
+	res := DB().Model(&SubscribeLastValue{}).
+		Where("subscribe_id = ?", subscribeID).
+		Where("entity_id = ?", entityID).
+		Where("revision = ?", revision).
+		Updates(map[string]interface{}{"properties": string(properties), "revision": revision + 1})
+	return res.RowsAffected == 1, res.Error
+}
+
+// PurgeLastValues deletes the last values of deleted subscribes and of
+// entities which are no longer subscribed.
+func PurgeLastValues() error {
+	if err := DB().Where("subscribe_id NOT IN (?)", DB().Model(&Subscribe{}).Select("id")).
+		Delete(&SubscribeLastValue{}).Error; err != nil {
+		return err
+	}
+	return DB().Where("(subscribe_id, entity_id) NOT IN (?)",
+		DB().Model(&SubscribeEntities{}).Select("subscribe_id, entity_id")).
+		Delete(&SubscribeLastValue{}).Error
+}
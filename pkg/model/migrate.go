@@ -0,0 +1,206 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/kit/log"
+	"gorm.io/gorm"
+)
+
+// ErrSchemaTooNew is returned when the database has been migrated by a newer
+// broker than this one.
+var ErrSchemaTooNew = errors.New("database schema is newer than the broker")
+
+// ErrSchemaMismatch is returned when an applied migration is recorded under
+// another name than the migration of its version, the database has been
+// migrated by a broker whose migrations were numbered differently.
+var ErrSchemaMismatch = errors.New("database schema does not match the migrations of the broker")
+
+// Migration changes the schema from the previous version to Version, Down
+// reverts it.
+type Migration struct {
+	Version uint
+	Name    string
+	Up      func(tx *gorm.DB) error
+	Down    func(tx *gorm.DB) error
+}
+
+// SchemaMigration records an applied migration.
+type SchemaMigration struct {
+	Version   uint `gorm:"primarykey;autoIncrement:false"`
+	Name      string
+	AppliedAt time.Time
+}
+
+func (SchemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// MigrationState is a migration and when it has been applied.
+type MigrationState struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+// LatestSchemaVersion is the schema version of this broker.
+func LatestSchemaVersion() uint {
+	return migrations[len(migrations)-1].Version
+}
+
+// SchemaVersion returns the version of the last applied migration, 0 if none
+// has been applied.
+func SchemaVersion() (uint, error) {
+	applied, err := appliedMigrations(DB())
+	if err != nil {
+		return 0, err
+	}
+	var version uint
+	for v := range applied {
+		if v > version {
+			version = v
+		}
+	}
+	return version, nil
+}
+
+// MigrationStatus lists the migrations of this broker.
+func MigrationStatus() ([]MigrationState, error) {
+	applied, err := appliedMigrations(DB())
+	if err != nil {
+		return nil, err
+	}
+	states := make([]MigrationState, 0, len(migrations))
+	for _, m := range migrations {
+		state := MigrationState{Migration: m}
+		if record, ok := applied[m.Version]; ok {
+			state.AppliedAt = &record.AppliedAt
+		}
+		states = append(states, state)
+	}
+	return states, nil
+}
+
+// MigrateUp applies the pending migrations up to the target version, all of
+// them if target is 0. The instances starting together migrate one after the
+// other.
+func MigrateUp(target uint) error {
+	return withMigrationLock(func() error {
+		return migrateUp(target)
+	})
+}
+
+func migrateUp(target uint) error {
+	if err := checkSchemaVersion(); err != nil {
+		return err
+	}
+	applied, err := appliedMigrations(DB())
+	if err != nil {
+		return err
+	}
+	for _, m := range migrations {
+		if target != 0 && m.Version > target {
+			break
+		}
+		if _, ok := applied[m.Version]; ok {
+			continue
+		}
+		log.Infof("apply migration %d %s", m.Version, m.Name)
+		if err = DB().Transaction(func(tx *gorm.DB) error {
+			if err := m.Up(tx); err != nil {
+				return err
+			}
+			return tx.Create(&SchemaMigration{Version: m.Version, Name: m.Name, AppliedAt: time.Now()}).Error
+		}); err != nil {
+			return errors.Wrapf(err, "apply migration %d %s", m.Version, m.Name)
+		}
+	}
+	return nil
+}
+
+// MigrateDown reverts the given number of the last applied migrations.
+func MigrateDown(steps int) error {
+	return withMigrationLock(func() error {
+		return migrateDown(steps)
+	})
+}
+
+func migrateDown(steps int) error {
+	if err := checkSchemaVersion(); err != nil {
+		return err
+	}
+	applied, err := appliedMigrations(DB())
+	if err != nil {
+		return err
+	}
+	for i := len(migrations) - 1; i >= 0 && steps > 0; i-- {
+		m := migrations[i]
+		if _, ok := applied[m.Version]; !ok {
+			continue
+		}
+		log.Infof("revert migration %d %s", m.Version, m.Name)
+		if err = DB().Transaction(func(tx *gorm.DB) error {
+			if err := m.Down(tx); err != nil {
+				return err
+			}
+			return tx.Delete(&SchemaMigration{Version: m.Version}).Error
+		}); err != nil {
+			return errors.Wrapf(err, "revert migration %d %s", m.Version, m.Name)
+		}
+		steps--
+	}
+	return nil
+}
+
+// withMigrationLock runs migrate holding the migration lock of the database,
+// the applied migrations are read under it.
+func withMigrationLock(migrate func() error) error {
+	pool, err := DB().DB()
+	if err != nil {
+		return errors.Wrap(err, "open database")
+	}
+	unlock, err := dialect.LockMigrations(context.Background(), pool)
+	if err != nil {
+		return errors.Wrap(err, "lock migrations")
+	}
+	defer unlock()
+	return migrate()
+}
+
+// checkSchemaVersion refuses a database migrated by a newer broker, or by one
+// whose migrations do not match those of this broker.
+func checkSchemaVersion() error {
+	version, err := SchemaVersion()
+	if err != nil {
+		return err
+	}
+	if version > LatestSchemaVersion() {
+		return errors.Wrapf(ErrSchemaTooNew, "schema version %d, broker version %d", version, LatestSchemaVersion())
+	}
+	applied, err := appliedMigrations(DB())
+	if err != nil {
+		return err
+	}
+	for _, m := range migrations {
+		if record, ok := applied[m.Version]; ok && record.Name != m.Name {
+			return errors.Wrapf(ErrSchemaMismatch, "migration %d is %s, applied as %s", m.Version, m.Name, record.Name)
+		}
+	}
+	return nil
+}
+
+func appliedMigrations(tx *gorm.DB) (map[uint]SchemaMigration, error) {
+	if err := tx.AutoMigrate(&SchemaMigration{}); err != nil {
+		return nil, errors.Wrap(err, "create migrations table")
+	}
+	records := make([]SchemaMigration, 0)
+	if err := tx.Find(&records).Error; err != nil {
+		return nil, errors.Wrap(err, "list applied migrations")
+	}
+	applied := make(map[uint]SchemaMigration, len(records))
+	for _, record := range records {
+		applied[record.Version] = record
+	}
+	return applied, nil
+}
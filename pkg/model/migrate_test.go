@@ -0,0 +1,183 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/core-broker/pkg/core/fake"
+	"github.com/tkeel-io/core-broker/pkg/subscribeuril"
+	"gorm.io/gorm"
+)
+
+func TestMigrationsOrdered(t *testing.T) {
+	for i, m := range migrations {
+		assert.Equal(t, uint(i+1), m.Version)
+		assert.NotEmpty(t, m.Name)
+		assert.NotNil(t, m.Up)
+		assert.NotNil(t, m.Down)
+	}
+}
+
+func TestMigrateUpDown(t *testing.T) {
+	openSQLite(t)
+	version, err := SchemaVersion()
+	assert.Nil(t, err)
+	assert.Equal(t, LatestSchemaVersion(), version)
+	assert.True(t, DB().Migrator().HasTable(&SubscribeEntities{}))
+
+	assert.Nil(t, MigrateDown(len(migrations)))
+	version, err = SchemaVersion()
+	assert.Nil(t, err)
+	assert.Equal(t, uint(0), version)
+	assert.False(t, DB().Migrator().HasTable(&SubscribeEntities{}))
+	states, err := MigrationStatus()
+	assert.Nil(t, err)
+	assert.Nil(t, states[0].AppliedAt)
+
+	assert.Nil(t, MigrateUp(0))
+	assert.True(t, DB().Migrator().HasTable(&SubscribeEntities{}))
+}
+
+func TestMigrationsMatchModels(t *testing.T) {
+	openSQLite(t)
+	for _, m := range []interface{}{&Subscribe{}, &SubscribeEntities{}, &SubscribeWindow{},
+		&SubscribeLastValue{}, &Audit{}, &WsInstance{}, &WsSubscription{}} {
+		stmt := &gorm.Statement{DB: DB()}
+		assert.Nil(t, stmt.Parse(m))
+		for _, column := range stmt.Schema.DBNames {
+			assert.True(t, DB().Migrator().HasColumn(m, column), "%s.%s", stmt.Schema.Table, column)
+		}
+	}
+}
+
+func TestMigrateBaselineForward(t *testing.T) {
+	assert.Nil(t, Connect(DriverSQLite, "file::memory:"))
+	t.Cleanup(func() {
+		pool, err := DB().DB()
+		assert.Nil(t, err)
+		pool.Close()
+		db, dialect = nil, mysqlDialect{}
+	})
+	// A database created by AutoMigrate before the versioned migrations.
+	assert.Nil(t, DB().AutoMigrate(&v1Subscribe{}, &v1SubscribeEntities{}))
+	assert.Nil(t, DB().Create(&v1Subscribe{Title: "dashboard", UserID: "usr-1", Endpoint: "ep1"}).Error)
+	assert.False(t, DB().Migrator().HasColumn(&Subscribe{}, "mode"))
+
+	assert.Nil(t, MigrateUp(0))
+	version, err := SchemaVersion()
+	assert.Nil(t, err)
+	assert.Equal(t, LatestSchemaVersion(), version)
+	var subscribe Subscribe
+	assert.Nil(t, DB().Where("endpoint = ?", "ep1").First(&subscribe).Error)
+	assert.Equal(t, "dashboard", subscribe.Title)
+	assert.Equal(t, ModeRealtime, subscribe.Mode)
+	assert.False(t, subscribe.Paused)
+	assert.True(t, DB().Migrator().HasColumn(&SubscribeEntities{}, "from_query"))
+	assert.True(t, DB().Migrator().HasTable(&Audit{}))
+}
+
+type lockingDialect struct {
+	sqliteDialect
+	locked, unlocked int
+}
+
+func (d *lockingDialect) LockMigrations(context.Context, *sql.DB) (func(), error) {
+	d.locked++
+	return func() { d.unlocked++ }, nil
+}
+
+func TestMigrateLocks(t *testing.T) {
+	openSQLite(t)
+	d := &lockingDialect{}
+	dialect = d
+	assert.Nil(t, MigrateUp(0))
+	assert.Nil(t, MigrateDown(1))
+	assert.Equal(t, 2, d.locked)
+	assert.Equal(t, 2, d.unlocked)
+
+	d.locked, d.unlocked = 0, 0
+	assert.Nil(t, DB().Create(&SchemaMigration{Version: LatestSchemaVersion() + 1, Name: "future", AppliedAt: time.Now()}).Error)
+	assert.NotNil(t, MigrateUp(0))
+	assert.Equal(t, 1, d.unlocked)
+}
+
+func TestMigrateSchemaTooNew(t *testing.T) {
+	openSQLite(t)
+	assert.Nil(t, DB().Create(&SchemaMigration{Version: LatestSchemaVersion() + 1, Name: "future", AppliedAt: time.Now()}).Error)
+	assert.True(t, errors.Is(MigrateUp(0), ErrSchemaTooNew))
+	assert.True(t, errors.Is(MigrateDown(1), ErrSchemaTooNew))
+}
+
+func TestMigrateSchemaMismatch(t *testing.T) {
+	openSQLite(t)
+	// A database migrated by a broker with another migration 3.
+	assert.Nil(t, DB().Model(&SchemaMigration{}).Where("version = ?", 3).Update("name", "subscribe_core_subscription").Error)
+	assert.True(t, errors.Is(MigrateUp(0), ErrSchemaMismatch))
+	assert.True(t, errors.Is(MigrateDown(1), ErrSchemaMismatch))
+}
+
+func TestMigrateSubscribeAddr(t *testing.T) {
+	openSQLite(t)
+	fakeCore := fake.New()
+	coreClient = fakeCore
+	t.Cleanup(func() { coreClient = nil })
+
+	legacy := "dashboard@1@amqp://localhost:3172/ep1"
+	fakeCore.AddEntity("iotd-1", "usr-1", map[string]interface{}{
+		"sysField": map[string]interface{}{"_subscribeAddr": legacy},
+	})
+	noHooks := DB().Session(&gorm.Session{SkipHooks: true})
+	assert.Nil(t, noHooks.Create(&SubscribeEntities{EntityID: "iotd-1", SubscribeID: 1, UniqueKey: "1_iotd-1"}).Error)
+
+	// Down to the baseline.
+	assert.Nil(t, MigrateDown(len(migrations)-1))
+	value, _ := fakeCore.Property("iotd-1", "sysField._subscribeAddr")
+	assert.Equal(t, legacy, value)
+
+	assert.Nil(t, MigrateUp(0))
+	value, _ = fakeCore.Property("iotd-1", "sysField._subscribeAddr")
+	assert.Equal(t, `[{"subscribe_id":1,"title":"dashboard","endpoint":"amqp://localhost:3172/ep1"}]`, value)
+}
+
+func TestMigrateSubscribeAddrLegacyTitle(t *testing.T) {
+	openSQLite(t)
+	fakeCore := fake.New()
+	coreClient = fakeCore
+	t.Cleanup(func() { coreClient = nil })
+
+	// Core still carries an old title of the second subscribe.
+	addrs := map[string]string{
+		"iotd-1": `[{"subscribe_id":1,"title":"a@b,c","endpoint":"amqp://localhost:3172/ep1"}]`,
+		"iotd-2": `[{"subscribe_id":2,"title":"old","endpoint":"amqp://localhost:3172/ep2"}]`,
+	}
+	noHooks := DB().Session(&gorm.Session{SkipHooks: true})
+	assert.Nil(t, noHooks.Create(&Subscribe{Title: "a@b,c", UserID: "usr-1"}).Error)
+	assert.Nil(t, noHooks.Create(&Subscribe{Title: "dashboard", UserID: "usr-1"}).Error)
+	for i, id := range []string{"iotd-1", "iotd-2"} {
+		fakeCore.AddEntity(id, "usr-1", map[string]interface{}{
+			"sysField": map[string]interface{}{"_subscribeAddr": addrs[id]},
+		})
+		subscribeID := uint(i + 1)
+		assert.Nil(t, noHooks.Create(&SubscribeEntities{EntityID: id, SubscribeID: subscribeID, UniqueKey: subscribeuril.GenerateSubscribeTopic(subscribeID, id)}).Error)
+	}
+
+	// The legacy entries cannot represent the first title, no entity is
+	// rewritten.
+	assert.NotNil(t, MigrateDown(len(migrations)-1))
+	for id, value := range addrs {
+		current, _ := fakeCore.Property(id, "sysField._subscribeAddr")
+		assert.Equal(t, value, current)
+	}
+
+	// Once it is renamed every entry is written with the current title.
+	assert.Nil(t, noHooks.Model(&Subscribe{}).Where("id = ?", 1).Update("title", "a-b-c").Error)
+	assert.Nil(t, MigrateDown(len(migrations)-1))
+	value, _ := fakeCore.Property("iotd-1", "sysField._subscribeAddr")
+	assert.Equal(t, "a-b-c@1@amqp://localhost:3172/ep1", value)
+	value, _ = fakeCore.Property("iotd-2", "sysField._subscribeAddr")
+	assert.Equal(t, "dashboard@2@amqp://localhost:3172/ep2", value)
+}
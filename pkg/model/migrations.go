@@ -0,0 +1,374 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core-broker/pkg/subscribeuril"
+	"github.com/tkeel-io/kit/log"
+	"gorm.io/gorm"
+)
+
+// migrations are the schema changes in version order, a migration must never
+// change once released. The tables are declared by snapshot types of the
+// migration instead of the models, which keep changing.
+var migrations = []Migration{
+	{
+		Version: 1,
+		Name:    "baseline",
+		// The schema created by AutoMigrate before versioned migrations,
+		// migrating a database created by it only records the version.
+		Up: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&v1Subscribe{}, &v1SubscribeEntities{})
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&v1SubscribeEntities{}, &v1Subscribe{})
+		},
+	},
+	{
+		Version: 2,
+		Name:    "delivery_modes",
+		// The aggregate and on-change delivery modes, the windows of the
+		// aggregates and the last values of the on-change subscribes.
+		Up: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&v2Subscribe{}, &v2SubscribeWindow{}, &v2SubscribeLastValue{})
+		},
+		Down: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropTable(&v2SubscribeLastValue{}, &v2SubscribeWindow{}); err != nil {
+				return err
+			}
+			return dropColumns(tx, &v2Subscribe{}, "mode", "aggregate_window", "aggregate_scope",
+				"aggregate_lateness", "deadband_type", "deadband_value")
+		},
+	},
+	{
+		Version: 3,
+		Name:    "subscribe_schedules",
+		// The active schedule and the expiry of the subscribes.
+		Up: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&v3Subscribe{})
+		},
+		Down: func(tx *gorm.DB) error {
+			return dropColumns(tx, &v3Subscribe{}, "active_schedule", "expires_at", "paused")
+		},
+	},
+	{
+		Version: 4,
+		Name:    "saved_queries",
+		// The query defining the entities of a subscribe, and which of its
+		// entities the query added.
+		Up: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&v4Subscribe{}, &v4SubscribeEntities{})
+		},
+		Down: func(tx *gorm.DB) error {
+			if err := dropColumns(tx, &v4SubscribeEntities{}, "from_query"); err != nil {
+				return err
+			}
+			return dropColumns(tx, &v4Subscribe{}, "query", "query_evaluated_at")
+		},
+	},
+	{
+		Version: 5,
+		Name:    "audits",
+		Up: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&v5Audit{})
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&v5Audit{})
+		},
+	},
+	{
+		Version: 6,
+		Name:    "structured_subscribe_addr",
+		// Rewrites the _subscribeAddr of the subscribed entities in core from
+		// the comma joined title@id@endpoint entries to the JSON list.
+		Up: func(tx *gorm.DB) error {
+			return rewriteSubscribeAddrs(tx, func(addrs subscribeuril.SubscribeAddrs) (string, error) {
+				return addrs.String(), nil
+			})
+		},
+		// The entries are written with the current titles, the legacy
+		// entries cannot represent the ones containing "@" or ",", it is
+		// refused until such subscribes are renamed.
+		Down: legacySubscribeAddrs,
+	},
+	{
+		Version: 7,
+		Name:    "ws_registry",
+		// The instances serving WebSocket clients and their core
+		// subscriptions.
+		Up: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&v7WsInstance{}, &v7WsSubscription{})
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&v7WsSubscription{}, &v7WsInstance{})
+		},
+	},
+	{
+		Version: 8,
+		Name:    "pending_memberships",
+		// Marks the memberships added in bulk until they are set up.
+		Up: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&v8SubscribeEntities{})
+		},
+		Down: func(tx *gorm.DB) error {
+			return dropColumns(tx, &v8SubscribeEntities{}, "pending_since")
+		},
+	},
+}
+
+// dropColumns drops the columns of the table of the snapshot type.
+func dropColumns(tx *gorm.DB, snapshot interface{}, columns ...string) error {
+	for _, column := range columns {
+		if err := tx.Migrator().DropColumn(snapshot, column); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// legacySubscribeAddrs rewrites the _subscribeAddr of every subscribed entity
+// as the legacy entries, with the titles of the subscribes in the database.
+// Every entity is checked before any is rewritten: it fails without a change
+// if core fails to return one or an entry cannot be represented, and fails
+// on the first entity core fails to rewrite.
+func legacySubscribeAddrs(tx *gorm.DB) error {
+	subscribes := make([]v1Subscribe, 0)
+	if err := tx.Unscoped().Select("id", "title").Find(&subscribes).Error; err != nil {
+		return err
+	}
+	titles := make(map[uint]string, len(subscribes))
+	for _, subscribe := range subscribes {
+		titles[subscribe.ID] = subscribe.Title
+	}
+	format := func(addrs subscribeuril.SubscribeAddrs) (string, error) {
+		for i := range addrs {
+			if title, ok := titles[addrs[i].SubscribeID]; ok {
+				addrs[i].Title = title
+			}
+		}
+		return addrs.Legacy()
+	}
+
+	entityIDs := make([]string, 0)
+	if err := tx.Table("subscribe_entities").Distinct("entity_id").Pluck("entity_id", &entityIDs).Error; err != nil {
+		return err
+	}
+	ctx := context.Background()
+	failures := make([]string, 0)
+	for _, entityID := range entityIDs {
+		if _, _, err := formatSubscribeAddr(ctx, entityID, format); err != nil {
+			failures = append(failures, fmt.Sprintf("entity %s: %v", entityID, err))
+		}
+	}
+	if len(failures) != 0 {
+		return errors.Errorf("cannot rewrite the _subscribeAddr of %d entities, rename the subscribes whose titles contain \"@\" or \",\" before migrating down: %s",
+			len(failures), strings.Join(failures, "; "))
+	}
+	for _, entityID := range entityIDs {
+		if err := rewriteSubscribeAddr(ctx, entityID, format); err != nil {
+			return errors.Wrapf(err, "rewrite _subscribeAddr of entity %s", entityID)
+		}
+	}
+	return nil
+}
+
+// rewriteSubscribeAddrs formats the _subscribeAddr of every subscribed
+// entity. The entities core fails to rewrite are logged and left as they
+// are, the _subscribeAddr of an entity is read in both representations and
+// is rewritten on its next change anyway.
+func rewriteSubscribeAddrs(tx *gorm.DB, format func(subscribeuril.SubscribeAddrs) (string, error)) error {
+	entityIDs := make([]string, 0)
+	if err := tx.Table("subscribe_entities").Distinct("entity_id").Pluck("entity_id", &entityIDs).Error; err != nil {
+		return err
+	}
+	ctx := context.Background()
+	failed := 0
+	for _, entityID := range entityIDs {
+		if err := rewriteSubscribeAddr(ctx, entityID, format); err != nil {
+			log.Errorf("rewrite _subscribeAddr of entity %s err: %v", entityID, err)
+			failed++
+		}
+	}
+	if failed != 0 {
+		log.Warnf("%d of %d entities keep their _subscribeAddr unchanged", failed, len(entityIDs))
+	}
+	return nil
+}
+
+func rewriteSubscribeAddr(ctx context.Context, entityID string, format func(subscribeuril.SubscribeAddrs) (string, error)) error {
+	unlock := subscribeAddrLocks.Lock(entityID)
+	defer unlock()
+
+	current, value, err := formatSubscribeAddr(ctx, entityID, format)
+	if err != nil {
+		return err
+	}
+	if value != current {
+		return patchSubscribeAddr(ctx, entityID, value)
+	}
+	return nil
+}
+
+// formatSubscribeAddr returns the current _subscribeAddr of the entity and
+// its formatted value.
+func formatSubscribeAddr(ctx context.Context, entityID string, format func(subscribeuril.SubscribeAddrs) (string, error)) (string, string, error) {
+	device, err := CoreClient().GetDeviceEntity(ctx, entityID)
+	if err != nil {
+		return "", "", err
+	}
+	current := device.Properties.SysField.SubscribeAddr
+	addrs, err := subscribeuril.ParseSubscribeAddrs(current)
+	if err != nil {
+		return "", "", err
+	}
+	value, err := format(addrs)
+	if err != nil {
+		return "", "", err
+	}
+	return current, value, nil
+}
+
+type v1Subscribe struct {
+	gorm.Model
+	Title       string `gorm:"not null"`
+	Description string
+	UserID      string `gorm:"index"`
+	TenantID    string `gorm:"index"`
+	Endpoint    string `gorm:"index"`
+	IsDefault   bool   `gorm:"default:false"`
+}
+
+func (v1Subscribe) TableName() string {
+	return "subscribes"
+}
+
+type v1SubscribeEntities struct {
+	EntityID    string `gorm:"index;not null"`
+	UniqueKey   string `gorm:"index;unique;size:255"`
+	SubscribeID uint   `gorm:"index;not null"`
+
+	Subscribe v1Subscribe
+}
+
+func (v1SubscribeEntities) TableName() string {
+	return "subscribe_entities"
+}
+
+type v2Subscribe struct {
+	Mode      string `gorm:"size:32;default:realtime"`
+	Aggregate struct {
+		Window   uint32
+		Scope    string `gorm:"size:32"`
+		Lateness uint32
+	} `gorm:"embedded;embeddedPrefix:aggregate_"`
+	Deadband struct {
+		Type  string `gorm:"size:32"`
+		Value float64
+	} `gorm:"embedded;embeddedPrefix:deadband_"`
+}
+
+func (v2Subscribe) TableName() string {
+	return "subscribes"
+}
+
+type v2SubscribeWindow struct {
+	ID          uint   `gorm:"primarykey"`
+	SubscribeID uint   `gorm:"uniqueIndex:idx_subscribe_window;not null"`
+	EntityID    string `gorm:"uniqueIndex:idx_subscribe_window;size:255"`
+	WindowStart int64  `gorm:"uniqueIndex:idx_subscribe_window"`
+	WindowEnd   int64
+	Deadline    int64  `gorm:"index"`
+	Values      string `gorm:"type:text"`
+	Emitted     bool   `gorm:"index;default:false"`
+}
+
+func (v2SubscribeWindow) TableName() string {
+	return "subscribe_windows"
+}
+
+type v2SubscribeLastValue struct {
+	ID          uint   `gorm:"primarykey"`
+	SubscribeID uint   `gorm:"uniqueIndex:idx_subscribe_last_value;not null"`
+	EntityID    string `gorm:"uniqueIndex:idx_subscribe_last_value;size:255"`
+	Properties  string `gorm:"type:text"`
+	Revision    uint   `gorm:"not null;default:0"`
+}
+
+func (v2SubscribeLastValue) TableName() string {
+	return "subscribe_last_values"
+}
+
+type v3Subscribe struct {
+	ActiveSchedule string     `gorm:"size:255"`
+	ExpiresAt      *time.Time `gorm:"index"`
+	Paused         bool       `gorm:"default:false"`
+}
+
+func (v3Subscribe) TableName() string {
+	return "subscribes"
+}
+
+type v4Subscribe struct {
+	Query            string `gorm:"type:text"`
+	QueryEvaluatedAt *time.Time
+}
+
+func (v4Subscribe) TableName() string {
+	return "subscribes"
+}
+
+type v4SubscribeEntities struct {
+	FromQuery bool `gorm:"default:false"`
+}
+
+func (v4SubscribeEntities) TableName() string {
+	return "subscribe_entities"
+}
+
+type v5Audit struct {
+	ID          uint      `gorm:"primarykey"`
+	CreatedAt   time.Time `gorm:"index"`
+	Action      string    `gorm:"size:64;index"`
+	EntityID    string    `gorm:"size:255;index"`
+	SubscribeID uint
+	UserID      string
+	Message     string `gorm:"type:text"`
+}
+
+func (v5Audit) TableName() string {
+	return "audits"
+}
+
+type v7WsInstance struct {
+	Name           string    `gorm:"primarykey;size:255"`
+	Holder         string    `gorm:"size:255"`
+	LeaseExpiresAt time.Time `gorm:"index"`
+}
+
+func (v7WsInstance) TableName() string {
+	return "ws_instances"
+}
+
+type v7WsSubscription struct {
+	ID        string `gorm:"primarykey;size:255"`
+	Instance  string `gorm:"index;size:255;not null"`
+	EntityID  string `gorm:"size:255"`
+	UserID    string `gorm:"size:255"`
+	CreatedAt time.Time
+}
+
+func (v7WsSubscription) TableName() string {
+	return "ws_subscriptions"
+}
+
+type v8SubscribeEntities struct {
+	PendingSince *time.Time
+}
+
+func (v8SubscribeEntities) TableName() string {
+	return "subscribe_entities"
+}
@@ -2,90 +2,96 @@ package model
 
 import (
 	"fmt"
-	"os"
 	"strings"
-	"sync"
 
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core-broker/pkg/config"
 	"github.com/tkeel-io/core-broker/pkg/core"
 	"github.com/tkeel-io/core-broker/pkg/pagination"
-	"github.com/tkeel-io/kit/log"
 
-	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 )
 
-const (
-	// schema like: "user:pass@tcp(127.0.0.1:3306)/dbname?charset=utf8mb4&parseTime=True&loc=Local"
-	dsnFromOSEnvKey = "DSN"
-	amqpServer      = "AMQP_SERVER"
-)
-
 type WhereOptions func() (query interface{}, args interface{})
 
 var (
-	_once      sync.Once
 	db         *gorm.DB
-	coreClient *core.Client
-
-	AMQPServerAddr = "amqp://localhost:3172"
+	dialect    Dialect = mysqlDialect{}
+	coreClient core.Client
+	amqpServer string
 )
 
-func CoreClient() *core.Client {
-	if coreClient != nil {
-		return coreClient
-	}
+// Options are what the models are set up with.
+type Options struct {
+	Database config.Database
+	// AMQPServer is the server of the endpoints of the subscribes.
+	AMQPServer string
+	// Core is the client the hooks of the models invoke core with.
+	Core core.Client
+}
 
-	client, err := core.NewCoreClient()
-	if err != nil {
-		log.Fatal(err)
-		return nil
-	}
-	coreClient = client
+// CoreClient returns the client the models invoke core with.
+func CoreClient() core.Client {
 	return coreClient
 }
 
-func Setup() error {
-	var err error
-
-	amqpServerStr := os.Getenv(amqpServer)
-	if amqpServerStr != "" {
-		AMQPServerAddr = amqpServerStr
+// Setup connects to the database of the options and applies the pending
+// migrations, it refuses a database migrated by a newer broker.
+func Setup(opts Options) error {
+	if err := SetupConnection(opts); err != nil {
+		return err
 	}
+	return MigrateUp(0)
+}
 
-	dsn := os.Getenv(dsnFromOSEnvKey)
+// SetupConnection connects to the database of the options without migrating
+// it.
+func SetupConnection(opts Options) error {
+	if err := Connect(opts.Database.Driver, opts.Database.DSN); err != nil {
+		return err
+	}
+	coreClient, amqpServer = opts.Core, opts.AMQPServer
+	return nil
+}
 
-	// Try to create DB first.
-	connectionInfo, dbName := withoutDBConnectionAndDBName(dsn)
-	noDBConnection, err := gorm.Open(mysql.Open(connectionInfo), nil)
+// Connect connects to the database of the DSN with the dialect of the driver
+// and makes it the database returned by DB.
+func Connect(driver, dsn string) error {
+	d, err := DialectOf(driver)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
-	if err = noDBConnection.Exec(createDBSQL(dbName)).Error; err != nil {
-		log.Fatal(err)
+	// Try to create DB first.
+	if err = d.EnsureDatabase(dsn); err != nil {
+		return errors.Wrap(err, "create database")
 	}
 
 	// Open the DB
-	db, err = gorm.Open(mysql.Open(dsn), &gorm.Config{})
+	conn, err := gorm.Open(d.Dialector(dsn), &gorm.Config{})
 	if err != nil {
-		log.Fatal(err)
+		return errors.Wrap(err, "open database")
 	}
-	return db.AutoMigrate(&Subscribe{}, &SubscribeEntities{})
+	pool, err := conn.DB()
+	if err != nil {
+		return errors.Wrap(err, "open database")
+	}
+	d.Configure(pool)
+	db, dialect = conn, d
+	return nil
+}
+
+// IsDuplicateKey reports whether err is a unique constraint violation.
+func IsDuplicateKey(err error) bool {
+	return dialect.IsDuplicateKey(err)
 }
 
 func AMQPAddressString(endpoint string) string {
-	return AMQPServerAddr + "/" + endpoint
+	return amqpServer + "/" + endpoint
 }
 
+// DB returns the database connected to by Setup.
 func DB() *gorm.DB {
-	if db == nil {
-		_once.Do(func() {
-			if err := Setup(); err != nil {
-				log.Error("Setup DB Error: ", err)
-				return
-			}
-		})
-	}
 	return db
 }
 
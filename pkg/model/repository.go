@@ -0,0 +1,264 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core-broker/pkg/pagination"
+	"github.com/tkeel-io/core-broker/pkg/subscribeuril"
+	"gorm.io/gorm"
+)
+
+var ErrSubscribeNotFound = errors.New("subscribe not found")
+
+// Repository stores the subscribes and their entities, the memberships. The
+// services reach the database through it, the dialect of the database it is
+// opened with selects the MySQL, PostgreSQL or SQLite backend.
+type Repository interface {
+	// GetSubscribe returns the subscribe of the ID owned by the user, or
+	// ErrSubscribeNotFound.
+	GetSubscribe(ctx context.Context, id uint, userID string) (*Subscribe, error)
+	// GetSubscribeByEndpoint returns the subscribe of the endpoint owned by
+	// the user, or ErrSubscribeNotFound.
+	GetSubscribeByEndpoint(ctx context.Context, endpoint, userID string) (*Subscribe, error)
+	// CountOwnedSubscribes counts the subscribes of the IDs owned by the user.
+	CountOwnedSubscribes(ctx context.Context, ids []uint, userID string) (int64, error)
+	// HasDefaultSubscribe reports whether the user has a default subscribe.
+	HasDefaultSubscribe(ctx context.Context, userID string) (bool, error)
+	// ListSubscribes lists the page of the subscribes of the user, all of
+	// them when the page is not required, and the number of its subscribes.
+	ListSubscribes(ctx context.Context, userID string, page pagination.Page) ([]Subscribe, int64, error)
+	// QuerySubscribes lists the subscribes whose entities are defined by a
+	// query.
+	QuerySubscribes(ctx context.Context) ([]Subscribe, error)
+	CreateSubscribe(ctx context.Context, subscribe *Subscribe) error
+	SaveSubscribe(ctx context.Context, subscribe *Subscribe) error
+	DeleteSubscribe(ctx context.Context, subscribe *Subscribe) error
+	// ClearSubscribeQuery removes the query of the subscribe.
+	ClearSubscribeQuery(ctx context.Context, subscribe *Subscribe) error
+	// ClaimQueryEvaluation marks the query of the subscribe as evaluated at
+	// now, it reports false if it was evaluated within the interval, e.g. by
+	// another broker instance.
+	ClaimQueryEvaluation(ctx context.Context, subscribe *Subscribe, now time.Time, interval time.Duration) (bool, error)
+
+	// EntityIDs lists the IDs of the entities of the subscribe.
+	EntityIDs(ctx context.Context, subscribeID uint) ([]string, error)
+	// PageEntityIDs lists the IDs of a page of the entities of the subscribe
+	// in order, and the number of its entities. A zero limit lists all of
+	// them.
+	PageEntityIDs(ctx context.Context, subscribeID uint, offset, limit int) ([]string, int64, error)
+	// QueryEntityIDs lists the IDs of the entities added by the query of the
+	// subscribe.
+	QueryEntityIDs(ctx context.Context, subscribeID uint) ([]string, error)
+	// ReleaseQueryEntities hands the entities added by the query of the
+	// subscribe over to be managed by hand.
+	ReleaseQueryEntities(ctx context.Context, subscribeID uint) error
+	// CountEntities counts the entities of the subscribe.
+	CountEntities(ctx context.Context, subscribeID uint) (int64, error)
+	// EntitySubscribeIDs lists the IDs of the subscribes of the entity.
+	EntitySubscribeIDs(ctx context.Context, entityID string) ([]uint, error)
+	// AddEntity adds the entity to the subscribe, the hooks of the
+	// membership subscribe it in core.
+	AddEntity(ctx context.Context, subscribeID uint, entityID string) error
+	// DeleteEntities removes the entities from the subscribe in a
+	// transaction, the hooks of the memberships unsubscribe them in core.
+	DeleteEntities(ctx context.Context, subscribe Subscribe, entityIDs []string) error
+}
+
+type gormRepository struct {
+	db *gorm.DB
+}
+
+// NewRepository returns the Repository of the database.
+func NewRepository(db *gorm.DB) Repository {
+	return &gormRepository{db: db}
+}
+
+func (r *gormRepository) GetSubscribe(ctx context.Context, id uint, userID string) (*Subscribe, error) {
+	return r.firstSubscribe(ctx, r.db.Where("id = ?", id).Where("user_id = ?", userID))
+}
+
+func (r *gormRepository) GetSubscribeByEndpoint(ctx context.Context, endpoint, userID string) (*Subscribe, error) {
+	return r.firstSubscribe(ctx, r.db.Where("endpoint = ?", endpoint).Where("user_id = ?", userID))
+}
+
+func (r *gormRepository) firstSubscribe(ctx context.Context, query *gorm.DB) (*Subscribe, error) {
+	subscribe := &Subscribe{}
+	if err := query.WithContext(ctx).First(subscribe).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSubscribeNotFound
+		}
+		return nil, err
+	}
+	return subscribe, nil
+}
+
+func (r *gormRepository) CountOwnedSubscribes(ctx context.Context, ids []uint, userID string) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&Subscribe{}).
+		Where("id IN ?", ids).
+		Where("user_id = ?", userID).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *gormRepository) HasDefaultSubscribe(ctx context.Context, userID string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&Subscribe{}).
+		Where("user_id = ?", userID).
+		Where("is_default = ?", true).
+		Count(&count).Error
+	return count != 0, err
+}
+
+func (r *gormRepository) ListSubscribes(ctx context.Context, userID string, page pagination.Page) ([]Subscribe, int64, error) {
+	subscribes := make([]Subscribe, 0)
+	query := r.db.WithContext(ctx).Where("user_id = ?", userID)
+	if page.Required() {
+		conditions, fields := page.SearchCondition()
+		if fields != nil {
+			query = query.Select(fields)
+		}
+		if conditions != nil {
+			query = query.Where(conditions)
+		}
+		query = query.Limit(int(page.Limit())).Offset(int(page.Offset()))
+	}
+	if err := query.Find(&subscribes).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var count int64
+	err := r.db.WithContext(ctx).Model(&Subscribe{}).
+		Where("user_id = ?", userID).
+		Count(&count).Error
+	return subscribes, count, err
+}
+
+func (r *gormRepository) QuerySubscribes(ctx context.Context) ([]Subscribe, error) {
+	subscribes := make([]Subscribe, 0)
+	err := r.db.WithContext(ctx).Where("query <> ?", "").Find(&subscribes).Error
+	return subscribes, err
+}
+
+func (r *gormRepository) CreateSubscribe(ctx context.Context, subscribe *Subscribe) error {
+	return r.db.WithContext(ctx).Create(subscribe).Error
+}
+
+func (r *gormRepository) SaveSubscribe(ctx context.Context, subscribe *Subscribe) error {
+	return r.db.WithContext(ctx).Save(subscribe).Error
+}
+
+func (r *gormRepository) DeleteSubscribe(ctx context.Context, subscribe *Subscribe) error {
+	return r.db.WithContext(ctx).Delete(subscribe).Error
+}
+
+func (r *gormRepository) ClearSubscribeQuery(ctx context.Context, subscribe *Subscribe) error {
+	err := r.db.WithContext(ctx).Model(subscribe).Updates(map[string]interface{}{
+		"query":              "",
+		"query_evaluated_at": nil,
+	}).Error
+	if err != nil {
+		return err
+	}
+	subscribe.Query = ""
+	subscribe.QueryEvaluatedAt = nil
+	return nil
+}
+
+func (r *gormRepository) ClaimQueryEvaluation(ctx context.Context, subscribe *Subscribe, now time.Time, interval time.Duration) (bool, error) {
+	res := r.db.WithContext(ctx).Model(&Subscribe{}).
+		Where("id = ?", subscribe.ID).
+		Where("query_evaluated_at IS NULL OR query_evaluated_at <= ?", now.Add(-interval)).
+		Update("query_evaluated_at", now)
+	if res.Error != nil || res.RowsAffected == 0 {
+		return false, res.Error
+	}
+	subscribe.QueryEvaluatedAt = &now
+	return true, nil
+}
+
+func (r *gormRepository) EntityIDs(ctx context.Context, subscribeID uint) ([]string, error) {
+	ids := make([]string, 0)
+	err := r.db.WithContext(ctx).Model(&SubscribeEntities{}).
+		Where("subscribe_id = ?", subscribeID).
+		Pluck("entity_id", &ids).Error
+	return ids, err
+}
+
+func (r *gormRepository) PageEntityIDs(ctx context.Context, subscribeID uint, offset, limit int) ([]string, int64, error) {
+	total, err := r.CountEntities(ctx, subscribeID)
+	if err != nil {
+		return nil, 0, err
+	}
+	ids := make([]string, 0)
+	query := r.db.WithContext(ctx).Model(&SubscribeEntities{}).Where("subscribe_id = ?", subscribeID)
+	if limit != 0 {
+		query = query.Offset(offset).Limit(limit)
+	}
+	err = query.Order("entity_id").Pluck("entity_id", &ids).Error
+	return ids, total, err
+}
+
+func (r *gormRepository) QueryEntityIDs(ctx context.Context, subscribeID uint) ([]string, error) {
+	ids := make([]string, 0)
+	err := r.db.WithContext(ctx).Model(&SubscribeEntities{}).
+		Where("subscribe_id = ?", subscribeID).
+		Where("from_query = ?", true).
+		Pluck("entity_id", &ids).Error
+	return ids, err
+}
+
+func (r *gormRepository) ReleaseQueryEntities(ctx context.Context, subscribeID uint) error {
+	return r.db.WithContext(ctx).Session(&gorm.Session{SkipHooks: true}).Model(&SubscribeEntities{}).
+		Where("subscribe_id = ?", subscribeID).
+		Where("from_query = ?", true).
+		Update("from_query", false).Error
+}
+
+func (r *gormRepository) CountEntities(ctx context.Context, subscribeID uint) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&SubscribeEntities{}).
+		Where("subscribe_id = ?", subscribeID).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *gormRepository) EntitySubscribeIDs(ctx context.Context, entityID string) ([]uint, error) {
+	ids := make([]uint, 0)
+	err := r.db.WithContext(ctx).Model(&SubscribeEntities{}).
+		Where("entity_id = ?", entityID).
+		Pluck("subscribe_id", &ids).Error
+	return ids, err
+}
+
+func (r *gormRepository) AddEntity(ctx context.Context, subscribeID uint, entityID string) error {
+	return r.db.WithContext(ctx).Create(&SubscribeEntities{
+		SubscribeID: subscribeID,
+		EntityID:    entityID,
+		UniqueKey:   subscribeuril.GenerateSubscribeTopic(subscribeID, entityID),
+	}).Error
+}
+
+func (r *gormRepository) DeleteEntities(ctx context.Context, subscribe Subscribe, entityIDs []string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, entityID := range entityIDs {
+			subscribeEntity := SubscribeEntities{
+				Subscribe:   subscribe,
+				EntityID:    entityID,
+				SubscribeID: subscribe.ID,
+				UniqueKey:   subscribeuril.GenerateSubscribeTopic(subscribe.ID, entityID),
+			}
+			err := tx.
+				Where("subscribe_id = ?", subscribeEntity.SubscribeID).
+				Where("entity_id = ?", subscribeEntity.EntityID).
+				Where("unique_key = ?", subscribeEntity.UniqueKey).
+				Delete(&subscribeEntity).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
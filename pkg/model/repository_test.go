@@ -0,0 +1,126 @@
+package model
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/core-broker/pkg/pagination"
+)
+
+func TestRepository(t *testing.T) {
+	openSQLite(t)
+	fakeCore := setupFakeCore(t)
+	for _, id := range []string{"iotd-1", "iotd-2", "iotd-3"} {
+		fakeCore.AddEntity(id, "usr-1", nil)
+	}
+	ctx := context.Background()
+	repo := NewRepository(DB())
+
+	hasDefault, err := repo.HasDefaultSubscribe(ctx, "usr-1")
+	assert.Nil(t, err)
+	assert.False(t, hasDefault)
+	defaults := Subscribe{Title: "default", UserID: "usr-1", IsDefault: true}
+	assert.Nil(t, repo.CreateSubscribe(ctx, &defaults))
+	hasDefault, err = repo.HasDefaultSubscribe(ctx, "usr-1")
+	assert.Nil(t, err)
+	assert.True(t, hasDefault)
+
+	subscribe := Subscribe{Title: "dashboard", UserID: "usr-1", Mode: ModeRealtime}
+	assert.Nil(t, repo.CreateSubscribe(ctx, &subscribe))
+	other := Subscribe{Title: "other", UserID: "usr-2"}
+	assert.Nil(t, repo.CreateSubscribe(ctx, &other))
+
+	found, err := repo.GetSubscribe(ctx, subscribe.ID, "usr-1")
+	assert.Nil(t, err)
+	assert.Equal(t, "dashboard", found.Title)
+	_, err = repo.GetSubscribe(ctx, subscribe.ID, "usr-2")
+	assert.ErrorIs(t, err, ErrSubscribeNotFound)
+	found, err = repo.GetSubscribeByEndpoint(ctx, subscribe.Endpoint, "usr-1")
+	assert.Nil(t, err)
+	assert.Equal(t, subscribe.ID, found.ID)
+	_, err = repo.GetSubscribeByEndpoint(ctx, subscribe.Endpoint, "usr-2")
+	assert.ErrorIs(t, err, ErrSubscribeNotFound)
+
+	owned, err := repo.CountOwnedSubscribes(ctx, []uint{defaults.ID, subscribe.ID, other.ID}, "usr-1")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), owned)
+
+	subscribes, count, err := repo.ListSubscribes(ctx, "usr-1", pagination.Page{Num: 2, Size: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), count)
+	assert.Len(t, subscribes, 1)
+	assert.Equal(t, subscribe.ID, subscribes[0].ID)
+	subscribes, count, err = repo.ListSubscribes(ctx, "usr-1", pagination.Page{})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), count)
+	assert.Len(t, subscribes, 2)
+
+	for _, id := range []string{"iotd-3", "iotd-1", "iotd-2"} {
+		assert.Nil(t, repo.AddEntity(ctx, subscribe.ID, id))
+	}
+	assert.Len(t, fakeCore.Subscriptions("iotd-1"), 1)
+	assert.NotNil(t, repo.AddEntity(ctx, subscribe.ID, "iotd-1"))
+
+	ids, total, err := repo.PageEntityIDs(ctx, subscribe.ID, 1, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), total)
+	assert.Equal(t, []string{"iotd-2"}, ids)
+	subscribeIDs, err := repo.EntitySubscribeIDs(ctx, "iotd-1")
+	assert.Nil(t, err)
+	assert.Equal(t, []uint{subscribe.ID}, subscribeIDs)
+
+	assert.Nil(t, repo.DeleteEntities(ctx, subscribe, []string{"iotd-1", "iotd-3"}))
+	ids, err = repo.EntityIDs(ctx, subscribe.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"iotd-2"}, ids)
+	assert.Len(t, fakeCore.Subscriptions("iotd-1"), 0)
+	count, err = repo.CountEntities(ctx, subscribe.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestRepositoryQuery(t *testing.T) {
+	openSQLite(t)
+	fakeCore := setupFakeCore(t)
+	fakeCore.AddEntity("iotd-1", "usr-1", nil)
+	ctx := context.Background()
+	repo := NewRepository(DB())
+
+	subscribe := Subscribe{Title: "dashboard", UserID: "usr-1", Query: `[{"field":"group","operator":"$eq","value":"g-1"}]`}
+	assert.Nil(t, repo.CreateSubscribe(ctx, &subscribe))
+	assert.Nil(t, repo.CreateSubscribe(ctx, &Subscribe{Title: "manual", UserID: "usr-1"}))
+	subscribes, err := repo.QuerySubscribes(ctx)
+	assert.Nil(t, err)
+	assert.Len(t, subscribes, 1)
+	assert.Equal(t, subscribe.ID, subscribes[0].ID)
+
+	now := time.Now()
+	claimed, err := repo.ClaimQueryEvaluation(ctx, &subscribe, now, time.Minute)
+	assert.Nil(t, err)
+	assert.True(t, claimed)
+	claimed, err = repo.ClaimQueryEvaluation(ctx, &subscribe, now.Add(time.Second), time.Minute)
+	assert.Nil(t, err)
+	assert.False(t, claimed)
+
+	assert.Nil(t, DB().Create(&SubscribeEntities{
+		EntityID:    "iotd-1",
+		SubscribeID: subscribe.ID,
+		UniqueKey:   "query-1",
+		FromQuery:   true,
+	}).Error)
+	ids, err := repo.QueryEntityIDs(ctx, subscribe.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"iotd-1"}, ids)
+
+	assert.Nil(t, repo.ClearSubscribeQuery(ctx, &subscribe))
+	assert.Equal(t, "", subscribe.Query)
+	assert.Nil(t, repo.ReleaseQueryEntities(ctx, subscribe.ID))
+	ids, err = repo.QueryEntityIDs(ctx, subscribe.ID)
+	assert.Nil(t, err)
+	assert.Empty(t, ids)
+	subscribes, err = repo.QuerySubscribes(ctx)
+	assert.Nil(t, err)
+	assert.Empty(t, subscribes)
+}
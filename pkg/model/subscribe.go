@@ -1,13 +1,15 @@
 package model
 
 import (
+	"context"
 	"crypto/md5"
 	"encoding/hex"
-	"strconv"
-	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/tkeel-io/core-broker/pkg/metrics"
+	"github.com/tkeel-io/core-broker/pkg/subscribeuril"
+	"github.com/tkeel-io/core-broker/pkg/types"
 	"github.com/tkeel-io/core-broker/pkg/util"
 	"github.com/tkeel-io/kit/log"
 	"gorm.io/gorm"
@@ -15,14 +17,105 @@ import (
 
 var ErrUndeleteable = errors.New("undeleteable")
 
+const (
+	// ModeRealtime delivers every property update straight from core to the
+	// subscription endpoint.
+	ModeRealtime = "realtime"
+	// ModeAggregate buffers property updates in the broker and delivers
+	// min/max/avg/count of numeric properties per tumbling window.
+	ModeAggregate = "aggregate"
+	// ModeOnChange delivers only the properties whose value changed beyond
+	// the deadband since they were last delivered.
+	ModeOnChange = "onchange"
+)
+
+const (
+	// AggregateScopeEntity aggregates every entity of a subscription separately.
+	AggregateScopeEntity = "entity"
+	// AggregateScopeSubscribe aggregates across all entities of a subscription.
+	AggregateScopeSubscribe = "subscribe"
+)
+
+const (
+	// DeadbandAbsolute suppresses numeric changes up to Value.
+	DeadbandAbsolute = "absolute"
+	// DeadbandPercent suppresses numeric changes up to Value percent of the
+	// last delivered value.
+	DeadbandPercent = "percent"
+)
+
+const (
+	// StateActive means property updates are delivered to the subscribe.
+	StateActive = "active"
+	// StatePaused means delivery is paused outside of the active schedule.
+	StatePaused = "paused"
+)
+
 type Subscribe struct {
 	gorm.Model
 	Title       string `gorm:"not null"`
 	Description string
-	UserID      string `gorm:"index"`
-	TenantID    string `gorm:"index"`
-	Endpoint    string `gorm:"index"`
-	IsDefault   bool   `gorm:"default:false"`
+	UserID      string           `gorm:"index"`
+	TenantID    string           `gorm:"index"`
+	Endpoint    string           `gorm:"index"`
+	IsDefault   bool             `gorm:"default:false"`
+	Mode        string           `gorm:"size:32;default:realtime"`
+	Aggregate   AggregateOptions `gorm:"embedded;embeddedPrefix:aggregate_"`
+	Deadband    DeadbandOptions  `gorm:"embedded;embeddedPrefix:deadband_"`
+	// ActiveSchedule is a cron expression, property updates are only
+	// delivered within the minutes it matches.
+	ActiveSchedule string `gorm:"size:255"`
+	// ExpiresAt is when the subscribe is deleted automatically.
+	ExpiresAt *time.Time `gorm:"index"`
+	// Paused is set while the core subscriptions are removed because the
+	// subscribe is outside of its ActiveSchedule.
+	Paused bool `gorm:"default:false"`
+	// Query is the JSON encoded device search conditions whose matches are
+	// the entities of the subscribe, empty when they are managed by hand.
+	Query            string `gorm:"type:text"`
+	QueryEvaluatedAt *time.Time
+}
+
+// AggregateOptions configures a subscribe in ModeAggregate.
+type AggregateOptions struct {
+	// Window is the tumbling window size in seconds.
+	Window uint32
+	// Scope is AggregateScopeEntity or AggregateScopeSubscribe.
+	Scope string `gorm:"size:32"`
+	// Lateness is how many seconds after a window ends events are still
+	// accepted into it, the window is delivered once it has passed.
+	Lateness uint32
+}
+
+// DeadbandOptions configures a subscribe in ModeOnChange, numeric properties
+// are compared against their last delivered value with the deadband, other
+// properties are delivered whenever they differ.
+type DeadbandOptions struct {
+	// Type is DeadbandAbsolute or DeadbandPercent.
+	Type  string `gorm:"size:32"`
+	Value float64
+}
+
+// Processed reports whether property updates of this subscribe have to pass
+// through the broker instead of going from core to the endpoint directly.
+func (s *Subscribe) Processed() bool {
+	return s.Mode != "" && s.Mode != ModeRealtime
+}
+
+func (s *Subscribe) State() string {
+	if s.Paused {
+		return StatePaused
+	}
+	return StateActive
+}
+
+// CoreTopic is the topic core publishes the property updates of this
+// subscribe to.
+func (s *Subscribe) CoreTopic() string {
+	if s.Processed() {
+		return types.DeliveryTopic
+	}
+	return s.Endpoint
 }
 
 func (s *Subscribe) BeforeCreate(tx *gorm.DB) error {
@@ -44,41 +137,153 @@ func (s *Subscribe) UpdateTenantID(userID, tenantID string) {
 	DB().Model(&Subscribe{}).Where(&Subscribe{UserID: userID}).Update("tenant_id", tenantID)
 }
 
-func (s *Subscribe) UpdateEndpointTitle(oldTitle, newTitle string) error {
+// UpdateEndpointTitle replaces the title of the subscribe in the
+// _subscribeAddr of its entities.
+func (s *Subscribe) UpdateEndpointTitle(ctx context.Context) error {
 	subEntities := make([]*SubscribeEntities, 0)
-	res := DB().Model(&SubscribeEntities{}).
-		Where(&SubscribeEntities{
-			SubscribeID: s.ID,
-		}).Find(&subEntities)
-	log.Info(res)
-	if res.Error != nil {
+	if err := DB().Model(&SubscribeEntities{}).
+		Where(&SubscribeEntities{SubscribeID: s.ID}).
+		Find(&subEntities).Error; err != nil {
+		return err
+	}
+	for _, e := range subEntities {
+		if err := updateEntitySubscribeEndpoint(ctx, e.EntityID, subscribeAddress(s), Add); err != nil {
+			log.Errorf("update subscribe address of entity %s err: %v", e.EntityID, err)
+		}
+	}
+	return nil
+}
+
+// RefreshCoreSubscriptions recreates the core subscriptions of every entity
+// of this subscribe, used when the topic core has to publish to has changed.
+func (s *Subscribe) RefreshCoreSubscriptions(ctx context.Context) error {
+	if s.Paused {
 		return nil
 	}
+	subEntities := make([]*SubscribeEntities, 0)
+	if err := DB().Model(&SubscribeEntities{}).
+		Where(&SubscribeEntities{SubscribeID: s.ID}).
+		Find(&subEntities).Error; err != nil {
+		return err
+	}
 	for _, e := range subEntities {
-		log.Info(e, oldTitle, newTitle)
-		subscribe := Subscribe{}
-		DB().Model(&subscribe).Where("id = ?", e.SubscribeID).First(&subscribe)
-		e.Subscribe = subscribe
-		if err := updateEntitySubscribeEndpoint(e.EntityID,
-			strings.Join([]string{
-				oldTitle, strconv.FormatUint(uint64(e.SubscribeID), 10),
-				AMQPAddressString(e.Subscribe.Endpoint),
-			}, "@"),
-			Reduce); err != nil {
-			log.Error("reduce entity subscribe endpoint err")
+		if err := deleteCoreSubscription(ctx, e.EntityID, s.Endpoint, s.UserID); err != nil {
+			log.Error("delete core subscription err:", err)
+		}
+		if err := createCoreSubscription(ctx, e.EntityID, s); err != nil {
+			return errors.Wrap(err, "create core subscription err")
+		}
+	}
+	return nil
+}
+
+// SetPaused pauses or resumes the delivery of the subscribe by removing or
+// recreating its core subscriptions, it reports false if the subscribe already
+// was in the requested state. Core is changed before the state is saved, so a
+// failed resume leaves the subscribe paused to be resumed again.
+func (s *Subscribe) SetPaused(ctx context.Context, paused bool) (bool, error) {
+	var stored Subscribe
+	if err := DB().Select("paused").Where("id = ?", s.ID).Take(&stored).Error; err != nil {
+		return false, err
+	}
+	if stored.Paused == paused {
+		s.Paused = paused
+		return false, nil
+	}
+
+	subEntities := make([]*SubscribeEntities, 0)
+	if err := DB().Model(&SubscribeEntities{}).
+		Where(&SubscribeEntities{SubscribeID: s.ID}).
+		Find(&subEntities).Error; err != nil {
+		return false, err
+	}
+	resumed := *s
+	resumed.Paused = false
+	for i, e := range subEntities {
+		if paused {
+			// The core subscription may be gone already.
+			if err := deleteCoreSubscription(ctx, e.EntityID, s.Endpoint, s.UserID); err != nil {
+				log.Error("delete core subscription err:", err)
+			}
 			continue
-		} else {
-			if err := updateEntitySubscribeEndpoint(e.EntityID,
-				strings.Join([]string{
-					newTitle, strconv.FormatUint(uint64(e.SubscribeID), 10),
-					AMQPAddressString(e.Subscribe.Endpoint),
-				}, "@"),
-				Add); err != nil {
-				log.Error("add entity subscribe endpoint err")
+		}
+		if err := createCoreSubscription(ctx, e.EntityID, &resumed); err != nil {
+			for _, created := range subEntities[:i] {
+				if undoErr := deleteCoreSubscription(context.Background(), created.EntityID, s.Endpoint, s.UserID); undoErr != nil {
+					log.Error("delete core subscription err:", undoErr)
+				}
 			}
+			return false, errors.Wrap(err, "create core subscription err")
 		}
 	}
-	return nil
+
+	res := DB().Model(&Subscribe{}).
+		Where("id = ?", s.ID).
+		Where("paused = ?", !paused).
+		Update("paused", paused)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	s.Paused = paused
+	return res.RowsAffected == 1, nil
+}
+
+// ScheduledSubscribes lists the subscribes with an active schedule and the
+// paused subscribes, whose schedule may have been removed since.
+func ScheduledSubscribes() ([]Subscribe, error) {
+	subscribes := make([]Subscribe, 0)
+	err := DB().Where("active_schedule <> ?", "").
+		Or("paused = ?", true).
+		Find(&subscribes).Error
+	return subscribes, err
+}
+
+// EntityIDs lists the IDs of the entities of the subscribe.
+func (s *Subscribe) EntityIDs() ([]string, error) {
+	ids := make([]string, 0)
+	err := DB().Model(&SubscribeEntities{}).
+		Where("subscribe_id = ?", s.ID).
+		Pluck("entity_id", &ids).Error
+	return ids, err
+}
+
+// ExpiredSubscribes lists the subscribes which expired before now.
+func ExpiredSubscribes(now time.Time) ([]Subscribe, error) {
+	subscribes := make([]Subscribe, 0)
+	err := DB().Where("expires_at IS NOT NULL").
+		Where("expires_at <= ?", now).
+		Find(&subscribes).Error
+	return subscribes, err
+}
+
+// ProcessedMemberships maps the core subscription ID of every entity of the
+// subscribes processed by the broker to its membership, with Subscribe loaded.
+func ProcessedMemberships() (map[string]SubscribeEntities, error) {
+	subscribes := make([]Subscribe, 0)
+	if err := DB().Where("mode <> ?", ModeRealtime).
+		Where("mode <> ?", "").
+		Find(&subscribes).Error; err != nil {
+		return nil, err
+	}
+	out := make(map[string]SubscribeEntities)
+	if len(subscribes) == 0 {
+		return out, nil
+	}
+	ids := make([]uint, 0, len(subscribes))
+	byID := make(map[uint]Subscribe, len(subscribes))
+	for i := range subscribes {
+		ids = append(ids, subscribes[i].ID)
+		byID[subscribes[i].ID] = subscribes[i]
+	}
+	records := make([]SubscribeEntities, 0)
+	if err := DB().Where("subscribe_id IN ?", ids).Find(&records).Error; err != nil {
+		return nil, err
+	}
+	for _, record := range records {
+		record.Subscribe = byID[record.SubscribeID]
+		out[CoreSubscriptionID(record.EntityID, record.Subscribe.Endpoint)] = record
+	}
+	return out, nil
 }
 
 func (s *Subscribe) BeforeDelete(tx *gorm.DB) error {
@@ -124,6 +329,12 @@ type SubscribeEntities struct {
 	EntityID    string `gorm:"index;not null"`
 	UniqueKey   string `gorm:"index;unique;size:255"`
 	SubscribeID uint   `gorm:"index;not null"`
+	// FromQuery is set if the query of the subscribe added the entity, the
+	// query only removes the entities it added.
+	FromQuery bool `gorm:"default:false"`
+	// PendingSince is set while the core subscription and the
+	// _subscribeAddr entry of a membership added in bulk are being set up.
+	PendingSince *time.Time
 
 	Subscribe Subscribe
 }
@@ -143,17 +354,12 @@ func (e *SubscribeEntities) AfterCreate(tx *gorm.DB) error {
 	tx.Model(&subscribe).Where("id = ?", e.SubscribeID).First(&subscribe)
 	e.Subscribe = subscribe
 	log.Debug("creation of SubscribeEntities:", *e)
-	if err := createCoreSubscription(e.EntityID, e.Subscribe.Endpoint, e.Subscribe.UserID); err != nil {
+	if err := createCoreSubscription(tx.Statement.Context, e.EntityID, &e.Subscribe); err != nil {
 		err = errors.Wrap(err, "create core subscription err")
 		log.Error(err)
 		return err
 	}
-	if err := updateEntitySubscribeEndpoint(e.EntityID,
-		strings.Join([]string{
-			e.Subscribe.Title, strconv.FormatUint(uint64(e.SubscribeID), 10),
-			AMQPAddressString(e.Subscribe.Endpoint),
-		}, "@"),
-		Add); err != nil {
+	if err := updateEntitySubscribeEndpoint(tx.Statement.Context, e.EntityID, e.subscribeAddress(), Add); err != nil {
 		err = errors.Wrap(err, "update entity subscribe endpoint err")
 		log.Error(err)
 		return err
@@ -168,15 +374,10 @@ func (e *SubscribeEntities) BeforeUpdate(tx *gorm.DB) error {
 		return nil
 	}
 	log.Debug("deleted of SubscribeEntities:", *e)
-	if err := updateEntitySubscribeEndpoint(e.EntityID,
-		strings.Join([]string{
-			e.Subscribe.Title, strconv.FormatUint(uint64(e.SubscribeID), 10),
-			AMQPAddressString(e.Subscribe.Endpoint),
-		}, "@"),
-		Reduce); err != nil {
+	if err := updateEntitySubscribeEndpoint(tx.Statement.Context, e.EntityID, e.subscribeAddress(), Reduce); err != nil {
 		return err
 	}
-	if err := deleteCoreSubscription(e.EntityID, e.Subscribe.Endpoint, e.Subscribe.UserID); err != nil {
+	if err := removeCoreSubscription(tx.Statement.Context, e.EntityID, &e.Subscribe); err != nil {
 		log.Error(err)
 		return err
 	}
@@ -198,17 +399,12 @@ func (e *SubscribeEntities) AfterUpdate(tx *gorm.DB) error {
 	e.Subscribe = subscribe
 	//	tx.Model(&e.Subscribe).Where("id = ?", e.SubscribeID).First(&e.Subscribe)
 	log.Debug("creation of SubscribeEntities:", *e)
-	if err := createCoreSubscription(e.EntityID, e.Subscribe.Endpoint, e.Subscribe.UserID); err != nil {
+	if err := createCoreSubscription(tx.Statement.Context, e.EntityID, &e.Subscribe); err != nil {
 		err = errors.Wrap(err, "create core subscription err")
 		log.Error(err)
 		return err
 	}
-	if err := updateEntitySubscribeEndpoint(e.EntityID,
-		strings.Join([]string{
-			e.Subscribe.Title, strconv.FormatUint(uint64(e.SubscribeID), 10),
-			AMQPAddressString(e.Subscribe.Endpoint),
-		}, "@"),
-		Add); err != nil {
+	if err := updateEntitySubscribeEndpoint(tx.Statement.Context, e.EntityID, e.subscribeAddress(), Add); err != nil {
 		err = errors.Wrap(err, "update entity subscribe endpoint err")
 		log.Error(err)
 		return err
@@ -231,28 +427,36 @@ func (e *SubscribeEntities) BeforeDelete(tx *gorm.DB) error {
 		return nil
 	}
 	log.Debug("deleted of SubscribeEntities:", *e)
-	if err := updateEntitySubscribeEndpoint(e.EntityID,
-		strings.Join([]string{
-			e.Subscribe.Title, strconv.FormatUint(uint64(e.SubscribeID), 10),
-			AMQPAddressString(e.Subscribe.Endpoint),
-		}, "@"),
-		Reduce); err != nil {
+	if err := updateEntitySubscribeEndpoint(tx.Statement.Context, e.EntityID, e.subscribeAddress(), Reduce); err != nil {
 		log.Error(err)
 		//		return err
 	}
-	if err := deleteCoreSubscription(e.EntityID, e.Subscribe.Endpoint, e.Subscribe.UserID); err != nil {
+	if err := removeCoreSubscription(tx.Statement.Context, e.EntityID, &e.Subscribe); err != nil {
 		log.Error(err)
 		//		return err
 	}
 	return nil
 }
 
-func createCoreSubscription(entityID string, topic, userID string) error {
-	return CoreClient().Subscribe(subscriptionIDByMD5AndPrefix(entityID, topic), entityID, topic, userID)
+func createCoreSubscription(ctx context.Context, entityID string, subscribe *Subscribe) error {
+	if subscribe.Paused {
+		// SetPaused creates the core subscription once delivery resumes.
+		return nil
+	}
+	return CoreClient().Subscribe(ctx, CoreSubscriptionID(entityID, subscribe.Endpoint), entityID, subscribe.CoreTopic(), subscribe.UserID)
+}
+
+func deleteCoreSubscription(ctx context.Context, entityID string, topic, userID string) error {
+	return CoreClient().Unsubscribe(ctx, CoreSubscriptionID(entityID, topic), userID)
 }
 
-func deleteCoreSubscription(entityID string, topic, userID string) error {
-	return CoreClient().Unsubscribe(subscriptionIDByMD5AndPrefix(entityID, topic), userID)
+// removeCoreSubscription deletes the core subscription of the entity unless
+// the subscribe is paused, in which case it has none.
+func removeCoreSubscription(ctx context.Context, entityID string, subscribe *Subscribe) error {
+	if subscribe.Paused {
+		return nil
+	}
+	return deleteCoreSubscription(ctx, entityID, subscribe.Endpoint, subscribe.UserID)
 }
 
 type UtilChoice uint8
@@ -262,56 +466,52 @@ const (
 	Reduce
 )
 
-func updateEntitySubscribeEndpoint(entityID, endpoint string, c UtilChoice) error {
-	separator := ","
-	patchData := make([]map[string]interface{}, 0)
+// subscribeAddrLocks serializes the read-modify-write of the _subscribeAddr
+// of an entity. Core has no conditional patch and the value is a single
+// string, so concurrent updates by other broker replicas can still race.
+var subscribeAddrLocks = util.NewKeyedMutex()
 
-	device, err := CoreClient().GetDeviceEntity(entityID)
+// updateEntitySubscribeEndpoint adds the entry of a subscribe to the
+// _subscribeAddr of the entity, or replaces it, or removes it. A legacy
+// _subscribeAddr is rewritten in the JSON representation.
+func updateEntitySubscribeEndpoint(ctx context.Context, entityID string, addr subscribeuril.SubscribeAddr, c UtilChoice) error {
+	unlock := subscribeAddrLocks.Lock(entityID)
+	defer unlock()
+
+	device, err := CoreClient().GetDeviceEntity(ctx, entityID)
 	log.Debug("get device entity:", device)
 	if err != nil {
 		log.Error("get entity err:", err)
 		return err
 	}
-	subscribeAddr := endpoint
+	current := device.Properties.SysField.SubscribeAddr
+	addrs, err := subscribeuril.ParseSubscribeAddrs(current)
+	if err != nil {
+		return errors.Wrapf(err, "parse _subscribeAddr of entity %s", entityID)
+	}
 	switch c {
 	case Add:
-		if strings.Contains(device.Properties.SysField.SubscribeAddr, endpoint) {
-			return nil
-		}
-		if device.Properties.SysField.SubscribeAddr != "" {
-			subscribeAddr = strings.Join([]string{device.Properties.SysField.SubscribeAddr, endpoint}, separator)
-		}
+		addrs.Add(addr)
 	case Reduce:
-		addrs := strings.Split(device.Properties.SysField.SubscribeAddr, separator)
-		validAddresses := make([]string, 0, len(addrs))
-		for i := range addrs {
-			if addrs[i] != endpoint {
-				log.Debugf("addrs[i]: %v, endpoint: %v", addrs[i], endpoint)
-				validAddresses = append(validAddresses, addrs[i])
-			}
-		}
-		if len(validAddresses) != 0 {
-			subscribeAddr = strings.Join(validAddresses, separator)
-		} else {
-			subscribeAddr = ""
-		}
-		log.Debugf("generated subscribeAddr: %s", subscribeAddr)
+		addrs.Remove(addr.SubscribeID)
+	}
+	subscribeAddr := addrs.String()
+	if subscribeAddr == current {
+		return nil
 	}
+	log.Debugf("generated subscribeAddr: %s", subscribeAddr)
+	return patchSubscribeAddr(ctx, entityID, subscribeAddr)
+}
 
-	patchData = append(patchData, map[string]interface{}{
+func patchSubscribeAddr(ctx context.Context, entityID, subscribeAddr string) error {
+	patchData := []map[string]interface{}{{
 		"operator": "replace",
 		"path":     "sysField._subscribeAddr",
 		"value":    subscribeAddr,
-	})
-
-	log.Debug("patchData:", patchData)
-	log.Debug("call patch on UtilChoice (Add 1, Reduce 2):", c)
-
-	if err = CoreClient().PatchEntity(entityID, patchData); err != nil {
-		err = errors.Wrap(err, "patch entity err")
-		return err
+	}}
+	if err := CoreClient().PatchEntity(ctx, entityID, patchData); err != nil {
+		return errors.Wrap(err, "patch entity err")
 	}
-
 	return nil
 }
 
@@ -321,7 +521,9 @@ func NewUndeleteable(content string) error {
 
 const prefix = "cb-"
 
-func subscriptionIDByMD5AndPrefix(entityID, topic string) string {
+// CoreSubscriptionID is the ID of the core subscription which delivers the
+// property updates of entityID to the subscribe with the given endpoint.
+func CoreSubscriptionID(entityID, topic string) string {
 	h := md5.New()
 	h.Write([]byte(entityID + topic))
 	return prefix + hex.EncodeToString(h.Sum(nil))
@@ -1,28 +1,128 @@
 package model
 
 import (
-	"github.com/stretchr/testify/assert"
-	"strings"
+	"context"
+	"errors"
+	"strconv"
+	"sync"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/core-broker/pkg/core/fake"
+	"github.com/tkeel-io/core-broker/pkg/subscribeuril"
+	"github.com/tkeel-io/core-broker/pkg/types"
 )
 
-func TestUpdateEntitySubscribeEndpoint(t *testing.T) {
-	subscribeAddr := ""
-	data := "Default Title@4@amqp://tkeel.io:5672/AAa1yvw7dYJGkuQU,123132@5@amqp://tkeel.io:5672/soV8UVBhdyLakMpR,1@6@amqp://tkeel.io:5672/Zwm1ihXdD7Q7eGcg,1test@7@amqp://tkeel.io:5672/ORc25nkwSMOUHSDe"
-	endpoint := "Default Title@4@amqp://tkeel.io:5672/AAa1yvw7dYJGkuQU"
+type subscribeAddrUpdate func(ctx context.Context, entityID string) error
+
+// concurrently runs the updates of the _subscribeAddr of the entity at the
+// same time and returns the resulting _subscribeAddr.
+func concurrently(t *testing.T, fakeCore *fake.Core, entityID string, updates ...subscribeAddrUpdate) subscribeuril.SubscribeAddrs {
+	var wg sync.WaitGroup
+	for _, update := range updates {
+		wg.Add(1)
+		go func(update subscribeAddrUpdate) {
+			defer wg.Done()
+			assert.Nil(t, update(context.Background(), entityID))
+		}(update)
+	}
+	wg.Wait()
+
+	value, _ := fakeCore.Property(entityID, "sysField._subscribeAddr")
+	addrs, err := subscribeuril.ParseSubscribeAddrs(types.Interface2string(value))
+	assert.Nil(t, err)
+	return addrs
+}
+
+func subscribeAddrChange(id uint, title string, c UtilChoice) subscribeAddrUpdate {
+	return func(ctx context.Context, entityID string) error {
+		addr := subscribeuril.SubscribeAddr{SubscribeID: id, Title: title, Endpoint: "amqp://localhost:3172/ep" + strconv.Itoa(int(id))}
+		return updateEntitySubscribeEndpoint(ctx, entityID, addr, c)
+	}
+}
+
+func TestConcurrentSubscribeAddrUpdates(t *testing.T) {
+	fakeCore := fake.New()
+	fakeCore.AddEntity("iotd-1", "usr-1", nil)
+	// A slow core makes unserialized get-then-replace updates overlap.
+	fakeCore.SetDelay(fake.MethodGetDeviceEntity, time.Millisecond)
+	fakeCore.SetDelay(fake.MethodPatchEntity, time.Millisecond)
+	coreClient = fakeCore
+	t.Cleanup(func() { coreClient = nil })
 
-	addrs := strings.Split(data, ",")
-	validAddresses := make([]string, 0, len(addrs))
-	for i := range addrs {
-		if addrs[i] != endpoint {
-			validAddresses = append(validAddresses, addrs[i])
+	updates := make([]subscribeAddrUpdate, 0)
+	for id := uint(1); id <= 20; id++ {
+		updates = append(updates, subscribeAddrChange(id, "subscribe", Add))
+	}
+	addrs := concurrently(t, fakeCore, "iotd-1", updates...)
+	assert.Len(t, addrs, 20)
+
+	// Title updates racing subscribes and unsubscribes of the entity.
+	updates = updates[:0]
+	for id := uint(1); id <= 10; id++ {
+		updates = append(updates, subscribeAddrChange(id, "renamed", Add))
+	}
+	for id := uint(11); id <= 20; id++ {
+		updates = append(updates, subscribeAddrChange(id, "", Reduce))
+	}
+	for id := uint(21); id <= 30; id++ {
+		updates = append(updates, subscribeAddrChange(id, "subscribe", Add))
+	}
+	addrs = concurrently(t, fakeCore, "iotd-1", updates...)
+	assert.Len(t, addrs, 20)
+	for _, addr := range addrs {
+		if addr.SubscribeID <= 10 {
+			assert.Equal(t, "renamed", addr.Title)
+		} else {
+			assert.Greater(t, addr.SubscribeID, uint(20))
 		}
 	}
-	if len(validAddresses) != 0 {
-		subscribeAddr = strings.Join(validAddresses, ",")
-	} else {
-		subscribeAddr = ""
+	assert.Equal(t, 0, subscribeAddrLocks.Len())
+}
+
+func TestSetPaused(t *testing.T) {
+	openSQLite(t)
+	fakeCore := setupFakeCore(t)
+	for _, id := range []string{"iotd-1", "iotd-2"} {
+		fakeCore.AddEntity(id, "usr-1", nil)
 	}
+	subscribe := Subscribe{Title: "dashboard", UserID: "usr-1", Mode: ModeRealtime}
+	assert.Nil(t, DB().Create(&subscribe).Error)
+	for _, id := range []string{"iotd-1", "iotd-2"} {
+		assert.Nil(t, DB().Create(&SubscribeEntities{SubscribeID: subscribe.ID, EntityID: id, UniqueKey: subscribeuril.GenerateSubscribeTopic(subscribe.ID, id)}).Error)
+	}
+	paused := func() bool {
+		var stored Subscribe
+		assert.Nil(t, DB().First(&stored, subscribe.ID).Error)
+		return stored.Paused
+	}
+
+	changed, err := subscribe.SetPaused(context.Background(), true)
+	assert.Nil(t, err)
+	assert.True(t, changed)
+	assert.True(t, paused())
+	assert.Empty(t, fakeCore.Subscriptions("iotd-1"))
+	assert.Empty(t, fakeCore.Subscriptions("iotd-2"))
+
+	// A resume that core fails leaves the subscribe paused, without core
+	// subscriptions, so that it is resumed again.
+	fakeCore.SetError(fake.MethodSubscribe, errors.New("core unavailable"))
+	_, err = subscribe.SetPaused(context.Background(), false)
+	assert.NotNil(t, err)
+	assert.True(t, paused())
+	assert.True(t, subscribe.Paused)
+	assert.Empty(t, fakeCore.Subscriptions("iotd-1"))
+
+	fakeCore.SetError(fake.MethodSubscribe, nil)
+	changed, err = subscribe.SetPaused(context.Background(), false)
+	assert.Nil(t, err)
+	assert.True(t, changed)
+	assert.False(t, paused())
+	assert.Len(t, fakeCore.Subscriptions("iotd-1"), 1)
+	assert.Len(t, fakeCore.Subscriptions("iotd-2"), 1)
 
-	assert.Equal(t, subscribeAddr, "123132@5@amqp://tkeel.io:5672/soV8UVBhdyLakMpR,1@6@amqp://tkeel.io:5672/Zwm1ihXdD7Q7eGcg,1test@7@amqp://tkeel.io:5672/ORc25nkwSMOUHSDe")
+	changed, err = subscribe.SetPaused(context.Background(), false)
+	assert.Nil(t, err)
+	assert.False(t, changed)
 }
@@ -0,0 +1,189 @@
+package model
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core-broker/pkg/subscribeuril"
+	"github.com/tkeel-io/kit/log"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrNotSubscribed     = errors.New("entity is not subscribed")
+	ErrAlreadySubscribed = errors.New("entity is already subscribed")
+)
+
+// ErrCoreSync wraps the errors of applying a transfer to core when the
+// database change has been kept.
+type ErrCoreSync struct {
+	Err error
+}
+
+func (e *ErrCoreSync) Error() string {
+	return "sync core err: " + e.Err.Error()
+}
+
+func (e *ErrCoreSync) Unwrap() error {
+	return e.Err
+}
+
+// transferStep is a change applied to core, undo reverts it.
+type transferStep struct {
+	name string
+	do   func(ctx context.Context) error
+	undo func(ctx context.Context) error
+}
+
+// TransferEntity moves the entity from one subscribe to another, or copies it
+// when move is false. The database rows are changed in one transaction and,
+// once it is committed, the core subscriptions and the _subscribeAddr of the
+// entity are changed step by step, so no transaction is held open across the
+// calls to core. If a step fails and rollback is set, the completed steps are
+// undone and the database change is reverted, otherwise the database change
+// is kept and an *ErrCoreSync is returned.
+func TransferEntity(ctx context.Context, entityID string, from, to *Subscribe, move, rollback bool) error {
+	err := DB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		noHooks := tx.Session(&gorm.Session{SkipHooks: true})
+		var count int64
+		if err := noHooks.Model(&SubscribeEntities{}).
+			Where("subscribe_id = ?", from.ID).
+			Where("entity_id = ?", entityID).
+			Count(&count).Error; err != nil {
+			return err
+		}
+		if count == 0 {
+			return ErrNotSubscribed
+		}
+		if err := noHooks.Model(&SubscribeEntities{}).
+			Where("subscribe_id = ?", to.ID).
+			Where("entity_id = ?", entityID).
+			Count(&count).Error; err != nil {
+			return err
+		}
+		if count != 0 {
+			return ErrAlreadySubscribed
+		}
+
+		if err := noHooks.Create(&SubscribeEntities{
+			EntityID:    entityID,
+			SubscribeID: to.ID,
+			UniqueKey:   subscribeuril.GenerateSubscribeTopic(to.ID, entityID),
+		}).Error; err != nil {
+			return err
+		}
+		if move {
+			return deleteTransferMembership(noHooks, entityID, from.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	steps := transferSteps(entityID, from, to, move)
+	for i := range steps {
+		if err = steps[i].do(ctx); err == nil {
+			continue
+		}
+		err = errors.Wrap(err, steps[i].name)
+		if !rollback {
+			return &ErrCoreSync{Err: err}
+		}
+		// The undo must run even if the caller has given up.
+		undoTransferSteps(context.Background(), entityID, steps[:i])
+		if revertErr := revertTransfer(context.Background(), entityID, from, to, move); revertErr != nil {
+			log.Errorf("revert transfer of entity %s err: %v", entityID, revertErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// revertTransfer restores the memberships changed by TransferEntity.
+func revertTransfer(ctx context.Context, entityID string, from, to *Subscribe, move bool) error {
+	return DB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		noHooks := tx.Session(&gorm.Session{SkipHooks: true})
+		if err := deleteTransferMembership(noHooks, entityID, to.ID); err != nil {
+			return err
+		}
+		if !move {
+			return nil
+		}
+		return noHooks.Create(&SubscribeEntities{
+			EntityID:    entityID,
+			SubscribeID: from.ID,
+			UniqueKey:   subscribeuril.GenerateSubscribeTopic(from.ID, entityID),
+		}).Error
+	})
+}
+
+func deleteTransferMembership(tx *gorm.DB, entityID string, subscribeID uint) error {
+	return tx.Where("subscribe_id = ?", subscribeID).
+		Where("entity_id = ?", entityID).
+		Delete(&SubscribeEntities{}).Error
+}
+
+func transferSteps(entityID string, from, to *Subscribe, move bool) []transferStep {
+	steps := []transferStep{
+		{
+			name: "create target core subscription",
+			do:   func(ctx context.Context) error { return createCoreSubscription(ctx, entityID, to) },
+			undo: func(ctx context.Context) error { return removeCoreSubscription(ctx, entityID, to) },
+		},
+		{
+			name: "add target subscribe address",
+			do: func(ctx context.Context) error {
+				return updateEntitySubscribeEndpoint(ctx, entityID, subscribeAddress(to), Add)
+			},
+			undo: func(ctx context.Context) error {
+				return updateEntitySubscribeEndpoint(ctx, entityID, subscribeAddress(to), Reduce)
+			},
+		},
+	}
+	if !move {
+		return steps
+	}
+	return append(steps,
+		transferStep{
+			name: "delete source core subscription",
+			do:   func(ctx context.Context) error { return removeCoreSubscription(ctx, entityID, from) },
+			undo: func(ctx context.Context) error { return createCoreSubscription(ctx, entityID, from) },
+		},
+		transferStep{
+			name: "reduce source subscribe address",
+			do: func(ctx context.Context) error {
+				return updateEntitySubscribeEndpoint(ctx, entityID, subscribeAddress(from), Reduce)
+			},
+			undo: func(ctx context.Context) error {
+				return updateEntitySubscribeEndpoint(ctx, entityID, subscribeAddress(from), Add)
+			},
+		},
+	)
+}
+
+func undoTransferSteps(ctx context.Context, entityID string, steps []transferStep) {
+	for i := len(steps) - 1; i >= 0; i-- {
+		if err := steps[i].undo(ctx); err != nil {
+			log.Errorf("undo %s of entity %s err: %v", steps[i].name, entityID, err)
+		}
+	}
+}
+
+// subscribeAddress is the entry of the subscribe in the _subscribeAddr of its
+// entities.
+func subscribeAddress(subscribe *Subscribe) subscribeuril.SubscribeAddr {
+	return subscribeuril.SubscribeAddr{
+		SubscribeID: subscribe.ID,
+		Title:       subscribe.Title,
+		Endpoint:    AMQPAddressString(subscribe.Endpoint),
+	}
+}
+
+// subscribeAddress is the entry of the subscribe of the membership, whose
+// Subscribe may only be partly loaded.
+func (e *SubscribeEntities) subscribeAddress() subscribeuril.SubscribeAddr {
+	addr := subscribeAddress(&e.Subscribe)
+	addr.SubscribeID = e.SubscribeID
+	return addr
+}
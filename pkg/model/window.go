@@ -0,0 +1,156 @@
+package model
+
+import (
+	"encoding/json"
+	"math"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ErrWindowClosed is returned when events are added to a window which has
+// already been delivered.
+var ErrWindowClosed = errors.New("window closed")
+
+// SubscribeWindow holds the partial aggregation results of one tumbling
+// window of a subscribe in ModeAggregate, so that windows survive broker
+// restarts and can be accumulated by several broker instances.
+type SubscribeWindow struct {
+	ID          uint   `gorm:"primarykey"`
+	SubscribeID uint   `gorm:"uniqueIndex:idx_subscribe_window;not null"`
+	EntityID    string `gorm:"uniqueIndex:idx_subscribe_window;size:255"`
+	WindowStart int64  `gorm:"uniqueIndex:idx_subscribe_window"`
+	WindowEnd   int64
+	Deadline    int64  `gorm:"index"`
+	Values      string `gorm:"type:text"`
+	Emitted     bool   `gorm:"index;default:false"`
+}
+
+// PropertyStats is the aggregation state of one numeric property.
+type PropertyStats struct {
+	Count uint64  `json:"count"`
+	Sum   float64 `json:"sum"`
+	Min   float64 `json:"min"`
+	Max   float64 `json:"max"`
+}
+
+func (s *PropertyStats) Observe(v float64) {
+	s.Merge(PropertyStats{Count: 1, Sum: v, Min: v, Max: v})
+}
+
+func (s *PropertyStats) Merge(o PropertyStats) {
+	if o.Count == 0 {
+		return
+	}
+	if s.Count == 0 {
+		*s = o
+		return
+	}
+	s.Count += o.Count
+	s.Sum += o.Sum
+	s.Min = math.Min(s.Min, o.Min)
+	s.Max = math.Max(s.Max, o.Max)
+}
+
+func (s PropertyStats) Avg() float64 {
+	if s.Count == 0 {
+		return 0
+	}
+	return s.Sum / float64(s.Count)
+}
+
+// Stats decodes the accumulated property stats of the window.
+func (w *SubscribeWindow) Stats() (map[string]PropertyStats, error) {
+	stats := make(map[string]PropertyStats)
+	if w.Values == "" {
+		return stats, nil
+	}
+	if err := json.Unmarshal([]byte(w.Values), &stats); err != nil {
+		return nil, errors.Wrap(err, "decode window values")
+	}
+	return stats, nil
+}
+
+// AccumulateWindow merges partial into the stored window, creating the window
+// if this is its first data.
+func AccumulateWindow(window SubscribeWindow, partial map[string]PropertyStats) error {
+	err := accumulateWindow(window, partial)
+	if IsDuplicateKey(err) {
+		// Another broker created the window concurrently, merge into it.
+		err = accumulateWindow(window, partial)
+	}
+	return err
+}
+
+func accumulateWindow(window SubscribeWindow, partial map[string]PropertyStats) error {
+	return DB().Transaction(func(tx *gorm.DB) error {
+		stored := SubscribeWindow{}
+		res := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("subscribe_id = ?", window.SubscribeID).
+			Where("entity_id = ?", window.EntityID).
+			Where("window_start = ?", window.WindowStart).
+			Limit(1).Find(&stored)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			stored = window
+		}
+		if stored.Emitted {
+			return ErrWindowClosed
+		}
+		stats, err := stored.Stats()
+		if err != nil {
+			return err
+		}
+		for property, s := range partial {
+			merged := stats[property]
+			merged.Merge(s)
+			stats[property] = merged
+		}
+		values, err := json.Marshal(stats)
+		if err != nil {
+			return err
+		}
+		stored.Values = string(values)
+		return tx.Save(&stored).Error
+	})
+}
+
+// DueWindows lists the undelivered windows whose deadline is not after now.
+func DueWindows(now int64) ([]SubscribeWindow, error) {
+	windows := make([]SubscribeWindow, 0)
+	err := DB().Where("emitted = ?", false).
+		Where("deadline <= ?", now).
+		Order("deadline").
+		Find(&windows).Error
+	return windows, err
+}
+
+// ClaimWindow marks the window as delivered, it reports false if another
+// broker instance has claimed it first.
+func ClaimWindow(id uint) (bool, error) {
+	res := DB().Model(&SubscribeWindow{}).
+		Where("id = ?", id).
+		Where("emitted = ?", false).
+		Update("emitted", true)
+	return res.RowsAffected == 1, res.Error
+}
+
+// ReleaseWindow hands a claimed window back for delivery.
+func ReleaseWindow(id uint) error {
+	return DB().Model(&SubscribeWindow{}).Where("id = ?", id).Update("emitted", false).Error
+}
+
+// PurgeWindows deletes delivered windows whose deadline is before the given
+// time, and the windows of deleted subscribes.
+func PurgeWindows(before int64) error {
+	if err := DB().Where("emitted = ?", true).
+		Where("deadline < ?", before).
+		Delete(&SubscribeWindow{}).Error; err != nil {
+		return err
+	}
+	return DB().Where("subscribe_id NOT IN (?)", DB().Model(&Subscribe{}).Select("id")).
+		Delete(&SubscribeWindow{}).Error
+}
@@ -0,0 +1,121 @@
+package model
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm/clause"
+)
+
+// ErrWsLeaseHeld is returned when another instance is collecting the core
+// subscriptions of the instance renewing its lease.
+var ErrWsLeaseHeld = errors.New("ws lease is held by another instance")
+
+// WsInstance is a broker instance serving WebSocket clients. Holder holds
+// its lease until LeaseExpiresAt, the instance itself while it renews the
+// lease and else the instance collecting its core subscriptions.
+type WsInstance struct {
+	Name           string    `gorm:"primarykey;size:255"`
+	Holder         string    `gorm:"size:255"`
+	LeaseExpiresAt time.Time `gorm:"index"`
+}
+
+// WsSubscription is the core subscription of an entity watched by the
+// WebSocket clients of an instance.
+type WsSubscription struct {
+	ID        string `gorm:"primarykey;size:255"`
+	Instance  string `gorm:"index;size:255;not null"`
+	EntityID  string `gorm:"size:255"`
+	UserID    string `gorm:"size:255"`
+	CreatedAt time.Time
+}
+
+// RenewWsLease extends the lease the instance holds on itself until
+// expiresAt. It reports false if the instance did not hold its lease, which
+// it takes then, because it is new, because it expired or because the
+// instance collecting it released it. While another instance holds it the
+// lease is left to it and ErrWsLeaseHeld is returned. The times are stored
+// in UTC so that they compare as text in SQLite.
+func RenewWsLease(instance string, now, expiresAt time.Time) (bool, error) {
+	res := DB().Model(&WsInstance{}).
+		Where("name = ? AND holder = ? AND lease_expires_at >= ?", instance, instance, now.UTC()).
+		Update("lease_expires_at", expiresAt.UTC())
+	if res.Error != nil {
+		return false, res.Error
+	}
+	if res.RowsAffected == 1 {
+		return true, nil
+	}
+	res = DB().Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&WsInstance{Name: instance, Holder: instance, LeaseExpiresAt: expiresAt.UTC()})
+	if res.Error != nil || res.RowsAffected == 1 {
+		return false, res.Error
+	}
+	res = DB().Model(&WsInstance{}).
+		Where("name = ? AND lease_expires_at < ?", instance, now.UTC()).
+		Updates(map[string]interface{}{"holder": instance, "lease_expires_at": expiresAt.UTC()})
+	if res.Error != nil || res.RowsAffected == 1 {
+		return false, res.Error
+	}
+	return false, ErrWsLeaseHeld
+}
+
+// ExpiredWsInstances lists the instances whose lease expired before now.
+func ExpiredWsInstances(now time.Time) ([]WsInstance, error) {
+	instances := make([]WsInstance, 0)
+	err := DB().Where("lease_expires_at < ?", now.UTC()).Find(&instances).Error
+	return instances, err
+}
+
+// ClaimWsInstance takes the expired lease of the instance for the holder
+// until expiresAt, it reports false if another holder took it first.
+func ClaimWsInstance(name, holder string, now, expiresAt time.Time) (bool, error) {
+	res := DB().Model(&WsInstance{}).
+		Where("name = ? AND lease_expires_at < ?", name, now.UTC()).
+		Updates(map[string]interface{}{"holder": holder, "lease_expires_at": expiresAt.UTC()})
+	return res.RowsAffected == 1, res.Error
+}
+
+// ReleaseWsInstance deletes the instance unless it has core subscriptions.
+func ReleaseWsInstance(name string) error {
+	return DB().Where("name = ?", name).
+		Where("name NOT IN (?)", DB().Model(&WsSubscription{}).Select("instance")).
+		Delete(&WsInstance{}).Error
+}
+
+// AddWsSubscription records the core subscription for the instance.
+func AddWsSubscription(subscription *WsSubscription) error {
+	return DB().Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"instance", "entity_id", "user_id"}),
+	}).Create(subscription).Error
+}
+
+// RemoveWsSubscription deletes the record of the core subscription.
+func RemoveWsSubscription(id string) error {
+	return DB().Where("id = ?", id).Delete(&WsSubscription{}).Error
+}
+
+// WsSubscriptions lists the core subscriptions of the instance.
+func WsSubscriptions(instance string) ([]WsSubscription, error) {
+	subscriptions := make([]WsSubscription, 0)
+	err := DB().Where("instance = ?", instance).Order("id").Find(&subscriptions).Error
+	return subscriptions, err
+}
+
+// CountWsSubscriptions counts the core subscriptions per instance.
+func CountWsSubscriptions() (map[string]int64, error) {
+	rows := make([]struct {
+		Instance string
+		Count    int64
+	}, 0)
+	if err := DB().Model(&WsSubscription{}).Select("instance, count(*) AS count").
+		Group("instance").Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Instance] = row.Count
+	}
+	return counts, nil
+}
@@ -0,0 +1,77 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWsRegistry(t *testing.T) {
+	openSQLite(t)
+	now := time.Now()
+	lease := 30 * time.Second
+
+	renewed, err := RenewWsLease("pod-a", now, now.Add(lease))
+	assert.Nil(t, err)
+	assert.False(t, renewed)
+	renewed, err = RenewWsLease("pod-a", now, now.Add(lease))
+	assert.Nil(t, err)
+	assert.True(t, renewed)
+	_, err = RenewWsLease("pod-b", now.Add(-2*lease), now.Add(-lease))
+	assert.Nil(t, err)
+	for _, s := range []WsSubscription{
+		{ID: "iotd-1_pod-a", Instance: "pod-a", EntityID: "iotd-1", UserID: "usr-1"},
+		{ID: "iotd-1_pod-b", Instance: "pod-b", EntityID: "iotd-1", UserID: "usr-1"},
+		{ID: "iotd-2_pod-b", Instance: "pod-b", EntityID: "iotd-2", UserID: "usr-2"},
+	} {
+		subscription := s
+		assert.Nil(t, AddWsSubscription(&subscription))
+	}
+	counts, err := CountWsSubscriptions()
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]int64{"pod-a": 1, "pod-b": 2}, counts)
+
+	expired, err := ExpiredWsInstances(now)
+	assert.Nil(t, err)
+	assert.Len(t, expired, 1)
+	assert.Equal(t, "pod-b", expired[0].Name)
+
+	// Only one instance claims the expired lease.
+	claimed, err := ClaimWsInstance("pod-b", "pod-a", now, now.Add(lease))
+	assert.Nil(t, err)
+	assert.True(t, claimed)
+	claimed, err = ClaimWsInstance("pod-b", "pod-c", now, now.Add(lease))
+	assert.Nil(t, err)
+	assert.False(t, claimed)
+
+	// The claimed instance waits until the collection is done.
+	renewed, err = RenewWsLease("pod-b", now, now.Add(lease))
+	assert.ErrorIs(t, err, ErrWsLeaseHeld)
+	assert.False(t, renewed)
+
+	assert.Nil(t, ReleaseWsInstance("pod-b"))
+	subscriptions, err := WsSubscriptions("pod-b")
+	assert.Nil(t, err)
+	assert.Len(t, subscriptions, 2)
+	for _, s := range subscriptions {
+		assert.Nil(t, RemoveWsSubscription(s.ID))
+	}
+	assert.Nil(t, ReleaseWsInstance("pod-b"))
+	var instances int64
+	assert.Nil(t, DB().Model(&WsInstance{}).Count(&instances).Error)
+	assert.Equal(t, int64(1), instances)
+
+	// Then it takes its lease again.
+	renewed, err = RenewWsLease("pod-b", now, now.Add(lease))
+	assert.Nil(t, err)
+	assert.False(t, renewed)
+	renewed, err = RenewWsLease("pod-b", now, now.Add(lease))
+	assert.Nil(t, err)
+	assert.True(t, renewed)
+
+	// An expired lease nobody claimed is taken back right away.
+	renewed, err = RenewWsLease("pod-b", now.Add(2*lease), now.Add(3*lease))
+	assert.Nil(t, err)
+	assert.False(t, renewed)
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package schedule pauses subscribes outside of their active schedule and
+// deletes expired subscribes.
+package schedule
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/robfig/cron/v3"
+	"github.com/tkeel-io/core-broker/pkg/model"
+	"github.com/tkeel-io/kit/log"
+)
+
+const tickInterval = 30 * time.Second
+
+// Parse parses the active schedule of a subscribe, a standard cron expression
+// optionally prefixed with CRON_TZ=<location>.
+func Parse(spec string) (cron.Schedule, error) {
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse active schedule %q", spec)
+	}
+	return schedule, nil
+}
+
+// Active reports whether the minute of now is matched by the schedule.
+func Active(schedule cron.Schedule, now time.Time) bool {
+	minute := now.Truncate(time.Minute)
+	return schedule.Next(minute.Add(-time.Second)).Equal(minute)
+}
+
+type Scheduler struct {
+	stop chan struct{}
+	done chan struct{}
+}
+
+func NewScheduler() *Scheduler {
+	return &Scheduler{
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+}
+
+// Run applies the schedules until Stop is called.
+func (s *Scheduler) Run() {
+	ticker := time.NewTicker(tickInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stop:
+			close(s.done)
+			return
+		case now := <-ticker.C:
+			s.expire(now)
+			s.apply(now)
+		}
+	}
+}
+
+func (s *Scheduler) Stop() {
+	close(s.stop)
+	<-s.done
+}
+
+func (s *Scheduler) expire(now time.Time) {
+	subscribes, err := model.ExpiredSubscribes(now)
+	if err != nil {
+		log.Error("list expired subscribes err:", err)
+		return
+	}
+	for i := range subscribes {
+		log.Infof("delete expired subscribe %d", subscribes[i].ID)
+		if err = model.DB().Delete(&subscribes[i]).Error; err != nil {
+			log.Errorf("delete expired subscribe %d err: %v", subscribes[i].ID, err)
+		}
+	}
+}
+
+func (s *Scheduler) apply(now time.Time) {
+	subscribes, err := model.ScheduledSubscribes()
+	if err != nil {
+		log.Error("list scheduled subscribes err:", err)
+		return
+	}
+	for i := range subscribes {
+		active := true
+		if subscribes[i].ActiveSchedule != "" {
+			schedule, err := Parse(subscribes[i].ActiveSchedule)
+			if err != nil {
+				log.Errorf("subscribe %d: %v", subscribes[i].ID, err)
+				continue
+			}
+			active = Active(schedule, now)
+		}
+		if active != subscribes[i].Paused {
+			continue
+		}
+		changed, err := subscribes[i].SetPaused(context.Background(), !active)
+		if err != nil {
+			log.Errorf("set subscribe %d paused to %t err: %v", subscribes[i].ID, !active, err)
+			continue
+		}
+		if changed {
+			log.Infof("subscribe %d is %s", subscribes[i].ID, subscribes[i].State())
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActive(t *testing.T) {
+	schedule, err := Parse("* 8-17 * * 1-5")
+	assert.Nil(t, err)
+
+	// 2022-04-18 is a Monday.
+	assert.True(t, Active(schedule, time.Date(2022, 4, 18, 8, 0, 0, 0, time.Local)))
+	assert.True(t, Active(schedule, time.Date(2022, 4, 18, 17, 59, 30, 0, time.Local)))
+	assert.False(t, Active(schedule, time.Date(2022, 4, 18, 18, 0, 0, 0, time.Local)))
+	assert.False(t, Active(schedule, time.Date(2022, 4, 17, 12, 0, 0, 0, time.Local)))
+
+	schedule, err = Parse("CRON_TZ=UTC * 9 * * *")
+	assert.Nil(t, err)
+	assert.True(t, Active(schedule, time.Date(2022, 4, 18, 9, 30, 0, 0, time.UTC)))
+	assert.False(t, Active(schedule, time.Date(2022, 4, 18, 10, 30, 0, 0, time.UTC)))
+
+	_, err = Parse("every day")
+	assert.NotNil(t, err)
+}
@@ -13,14 +13,12 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/tkeel-io/core-broker/pkg/config"
 	"github.com/tkeel-io/kit/log"
 	transportHTTP "github.com/tkeel-io/kit/transport/http"
 )
 
 const (
-	coreUrl string = "http://localhost:3500/v1.0/invoke/keel/method/apis/core/v1/entities"
-	authUrl string = "http://localhost:3500/v1.0/invoke/keel/method/apis/security"
-
 	//  coreUrl string = "http://192.168.123.9:31438/v1/entities"
 	//  authUrl string = "http://192.168.123.11:30707/apis/security"
 
@@ -38,6 +36,11 @@ const (
 	Source        = "source"
 	UserToken     = "user_token"
 	SubscribePath = "sysField._subscribeAddr"
+	ParentID      = "basicInfo.parentId"
+	TemplateID    = "basicInfo.templateId"
+	EntityName    = "basicInfo.name"
+	OnlineStatus  = "connectInfo._online"
+	UpdatedAt     = "sysField._updatedAt"
 )
 
 type User struct {
@@ -47,6 +50,8 @@ type User struct {
 }
 
 type CoreClient struct {
+	coreUrl string
+	authUrl string
 	// entity_create = "id={entity_id}&type={entity_type}&owner={user_id}&source={source}".format(**query)
 	// url 	   string
 	// id  	   string
@@ -55,13 +60,18 @@ type CoreClient struct {
 	// source     string
 }
 
-func NewCoreClient() *CoreClient {
-	return &CoreClient{}
+// NewCoreClient points the core and security APIs at the keel app of the
+// dapr settings.
+func NewCoreClient(dapr config.Dapr) *CoreClient {
+	return &CoreClient{
+		coreUrl: dapr.InvokeURL(dapr.KeelAppID, "apis/core/v1/entities"),
+		authUrl: dapr.InvokeURL(dapr.KeelAppID, "apis/security"),
+	}
 }
 
 // GetCoreUrl get core url
 func (c *CoreClient) GetCoreUrl(midUrl string, mapUrl map[string]string, entityType string) string {
-	url := fmt.Sprintf(coreUrl+midUrl+"?"+"type=%s&owner=%s&source=%s", entityType, mapUrl["owner"], mapUrl["source"])
+	url := fmt.Sprintf(c.coreUrl+midUrl+"?"+"type=%s&owner=%s&source=%s", entityType, mapUrl["owner"], mapUrl["source"])
 	return url
 }
 
@@ -83,7 +93,7 @@ func (c *CoreClient) User(ctx context.Context) (*User, error) {
 		return nil, errors.New("invalid Authorization")
 	}
 
-	url := authUrl + "/v1/oauth/authenticate"
+	url := c.authUrl + "/v1/oauth/authenticate"
 	req, err := http.NewRequest("GET", url, nil)
 	if nil != err {
 		return nil, err
@@ -128,7 +138,7 @@ func (c *CoreClient) User(ctx context.Context) (*User, error) {
 }
 
 func (c *CoreClient) parseToken(token string) (map[string]string, error) {
-	url := authUrl + "/v1/oauth/authenticate"
+	url := c.authUrl + "/v1/oauth/authenticate"
 	req, err := http.NewRequest("GET", url, nil)
 	if nil != err {
 		return nil, err
@@ -243,7 +253,7 @@ func (c *CoreClient) ParseResp(resp *http.Response, err error) ([]byte, error) {
 func (c *CoreClient) CreatEntityToken(entityType, id, owner string, token string) (string, error) {
 	// get url and request body
 	log.Debug("CreateEntityToken")
-	url := authUrl + "/v1/entity/token"
+	url := c.authUrl + "/v1/entity/token"
 	log.Debug("post auth url: ", url)
 	tokenReq := map[string]interface{}{
 		"entity_id":   id,
@@ -4,26 +4,45 @@ import (
 	"context"
 
 	pb "github.com/tkeel-io/core-broker/api/dapr"
+	"github.com/tkeel-io/core-broker/pkg/config"
 	"github.com/tkeel-io/core-broker/pkg/types"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 type DaprSubscribeService struct {
 	pb.UnimplementedSubscribeServer
+	pubsubName         string
+	entityDeletedTopic string
 }
 
-func NewDaprSubscribeService() *DaprSubscribeService {
-	return &DaprSubscribeService{}
+// NewDaprSubscribeService subscribes the topics of the pubsub of the dapr
+// settings, the topic of the deleted entities too unless it is empty.
+func NewDaprSubscribeService(conf config.Dapr) *DaprSubscribeService {
+	return &DaprSubscribeService{pubsubName: conf.PubsubName, entityDeletedTopic: conf.EntityDeletedTopic}
 }
 
 func (s *DaprSubscribeService) GetSubscribe(ctx context.Context, req *emptypb.Empty) (*pb.ListTopicSubscriptionsResponse, error) {
 	resp := &pb.ListTopicSubscriptionsResponse{}
 	resp.Subscriptions = append(resp.Subscriptions, &pb.TopicSubscription{
-		Pubsubname: types.PubsubName,
+		Pubsubname: s.pubsubName,
 		Topic:      types.Topic,
 		Metadata:   map[string]string{},
 		Route:      "/v1/topic",
 	})
+	resp.Subscriptions = append(resp.Subscriptions, &pb.TopicSubscription{
+		Pubsubname: s.pubsubName,
+		Topic:      types.DeliveryTopic,
+		Metadata:   map[string]string{},
+		Route:      "/v1/topic",
+	})
+	if s.entityDeletedTopic != "" {
+		resp.Subscriptions = append(resp.Subscriptions, &pb.TopicSubscription{
+			Pubsubname: s.pubsubName,
+			Topic:      s.entityDeletedTopic,
+			Metadata:   map[string]string{},
+			Route:      "/v1/topic",
+		})
+	}
 
 	return resp, nil
 }
@@ -0,0 +1,488 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	pb "github.com/tkeel-io/core-broker/api/subscribe/v1"
+	topicpb "github.com/tkeel-io/core-broker/api/topic/v1"
+	"github.com/tkeel-io/core-broker/pkg/config"
+	"github.com/tkeel-io/core-broker/pkg/core/fake"
+	"github.com/tkeel-io/core-broker/pkg/delivery"
+	"github.com/tkeel-io/core-broker/pkg/deviceutil"
+	"github.com/tkeel-io/core-broker/pkg/model"
+	"github.com/tkeel-io/core-broker/pkg/subscribeuril"
+	"github.com/tkeel-io/core-broker/pkg/types"
+	transportHTTP "github.com/tkeel-io/kit/transport/http"
+	"gorm.io/gorm"
+)
+
+type publication struct {
+	topic string
+	data  map[string]interface{}
+}
+
+type recordingPublisher struct {
+	lock         sync.Mutex
+	publications []publication
+}
+
+func (p *recordingPublisher) Publish(ctx context.Context, topic string, data []byte) error {
+	kv := make(map[string]interface{})
+	if err := json.Unmarshal(data, &kv); err != nil {
+		return err
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.publications = append(p.publications, publication{topic: topic, data: kv})
+	return nil
+}
+
+func (p *recordingPublisher) Publications() []publication {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return append([]publication(nil), p.publications...)
+}
+
+// newTestSubscribeService runs the subscribe service on an in-memory SQLite
+// database and the fake core.
+func newTestSubscribeService(t *testing.T) (*SubscribeService, *fake.Core) {
+	c := config.Default()
+	fakeCore := fake.New()
+	assert.Nil(t, model.Setup(model.Options{
+		Database:   config.Database{Driver: model.DriverSQLite, DSN: "file::memory:"},
+		AMQPServer: c.AMQPServer,
+		Core:       fakeCore,
+	}))
+	s := NewSubscribeService(
+		model.NewRepository(model.DB()),
+		deviceutil.NewServices(c.Dapr),
+		deviceutil.NewEntityCache(c.DeviceCache.Size, c.DeviceCache.TTL),
+		c.BulkSubscribe,
+	)
+	t.Cleanup(func() {
+		if pool, err := model.DB().DB(); err == nil {
+			pool.Close()
+		}
+	})
+	return s, fakeCore
+}
+
+func userContext(userID, tenantID string) context.Context {
+	auth := base64.StdEncoding.EncodeToString([]byte("user=" + userID + "&role=admin&tenant=" + tenantID))
+	return transportHTTP.ContextWithHeader(context.Background(), http.Header{"X-Tkeel-Auth": []string{auth}})
+}
+
+func TestSubscribeToDelivery(t *testing.T) {
+	s, fakeCore := newTestSubscribeService(t)
+	fakeCore.AddEntity("iotd-1", "usr-1", map[string]interface{}{
+		"basicInfo": map[string]interface{}{"name": "device 1"},
+	})
+	ctx := userContext("usr-1", "tenant-1")
+
+	created, err := s.CreateSubscribe(ctx, &pb.CreateSubscribeRequest{Title: "dashboard", Mode: model.ModeOnChange})
+	assert.Nil(t, err)
+	_, err = s.SubscribeEntitiesByIDs(ctx, &pb.SubscribeEntitiesByIDsRequest{Id: created.Id, Entities: []string{"iotd-1"}})
+	assert.Nil(t, err)
+
+	subscriptions := fakeCore.Subscriptions("iotd-1")
+	assert.Len(t, subscriptions, 1)
+	assert.Equal(t, model.CoreSubscriptionID("iotd-1", created.Endpoint), subscriptions[0].ID)
+	assert.Equal(t, types.DeliveryTopic, subscriptions[0].Topic)
+	addr, _ := fakeCore.Property("iotd-1", "sysField._subscribeAddr")
+	addrs, err := subscribeuril.ParseSubscribeAddrs(types.Interface2string(addr))
+	assert.Nil(t, err)
+	assert.Equal(t, subscribeuril.SubscribeAddrs{{
+		SubscribeID: uint(created.Id),
+		Title:       "dashboard",
+		Endpoint:    model.AMQPAddressString(created.Endpoint),
+	}}, addrs)
+
+	publisher := &recordingPublisher{}
+	topics := NewTopicService(delivery.NewProcessor(publisher, s.entities), s.entities, "")
+	fakeCore.OnEvent(func(ctx context.Context, req *topicpb.TopicEventRequest) (*topicpb.TopicEventResponse, error) {
+		resp, err := topics.TopicEventHandler(ctx, req)
+		assert.Equal(t, SubscriptionResponseStatusSuccess, resp.Status)
+		return resp, err
+	})
+	assert.Nil(t, fakeCore.Emit("iotd-1", map[string]interface{}{"temp": 20.5}))
+	assert.Nil(t, fakeCore.Emit("iotd-1", map[string]interface{}{"temp": 20.5}))
+	assert.Nil(t, fakeCore.Emit("iotd-1", map[string]interface{}{"temp": 21.0}))
+
+	publications := publisher.Publications()
+	assert.Len(t, publications, 2)
+	for _, p := range publications {
+		assert.Equal(t, created.Endpoint, p.topic)
+	}
+	assert.Equal(t, map[string]interface{}{"temp": 21.0}, publications[1].data["properties"])
+
+	_, err = s.UnsubscribeEntitiesByIDs(ctx, &pb.UnsubscribeEntitiesByIDsRequest{Id: created.Id, Entities: []string{"iotd-1"}})
+	assert.Nil(t, err)
+	assert.Empty(t, fakeCore.Subscriptions("iotd-1"))
+	addr, _ = fakeCore.Property("iotd-1", "sysField._subscribeAddr")
+	assert.Equal(t, "", addr)
+}
+
+func TestUpdateSubscribeKeepsSchedule(t *testing.T) {
+	s, _ := newTestSubscribeService(t)
+	ctx := userContext("usr-1", "tenant-1")
+	// The first subscribe of a user is the default one, which cannot change.
+	_, err := s.CreateSubscribe(ctx, &pb.CreateSubscribeRequest{Title: "default"})
+	assert.Nil(t, err)
+	expiresAt := time.Now().Add(time.Hour).Unix()
+	created, err := s.CreateSubscribe(ctx, &pb.CreateSubscribeRequest{
+		Title:          "dashboard",
+		ActiveSchedule: "* 8-18 * * *",
+		ExpiresAt:      expiresAt,
+	})
+	assert.Nil(t, err)
+
+	updated, err := s.UpdateSubscribe(ctx, &pb.UpdateSubscribeRequest{Id: created.Id, Title: "renamed"})
+	assert.Nil(t, err)
+	assert.Equal(t, "renamed", updated.Title)
+	assert.Equal(t, "* 8-18 * * *", updated.ActiveSchedule)
+	assert.Equal(t, expiresAt, updated.ExpiresAt)
+
+	updated, err = s.UpdateSubscribe(ctx, &pb.UpdateSubscribeRequest{
+		Id:             created.Id,
+		Title:          "renamed",
+		ActiveSchedule: clearActiveSchedule,
+		ExpiresAt:      clearExpiresAt,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "", updated.ActiveSchedule)
+	assert.Equal(t, int64(0), updated.ExpiresAt)
+	assert.Equal(t, model.StateActive, updated.State)
+}
+
+// fakeDeviceSearch serves the device search of the service for the test,
+// devices returns the IDs of the devices matched by the conditions of a
+// search.
+func fakeDeviceSearch(t *testing.T, s *SubscribeService, devices func(conditions deviceutil.Conditions) []string) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := deviceutil.SearchRequest{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		items := make([]map[string]interface{}, 0)
+		for _, id := range devices(req.Conditions) {
+			items = append(items, map[string]interface{}{"id": id})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"code": _searchSuccessCode,
+			"data": map[string]interface{}{
+				"listDeviceObject": map[string]interface{}{"items": items, "total": len(items)},
+			},
+		})
+	}))
+	s.devices.DeviceSearch = deviceutil.Service(server.URL)
+	t.Cleanup(server.Close)
+}
+
+func entityStatuses(results []*pb.EntityResult) map[string]string {
+	statuses := make(map[string]string, len(results))
+	for _, r := range results {
+		statuses[r.Id] = r.Status
+	}
+	return statuses
+}
+
+func TestUnsubscribeEntitiesByGroups(t *testing.T) {
+	s, fakeCore := newTestSubscribeService(t)
+	for _, id := range []string{"iotd-1", "iotd-2", "iotd-3"} {
+		fakeCore.AddEntity(id, "usr-1", nil)
+	}
+	searched := make([]deviceutil.Conditions, 0)
+	fakeDeviceSearch(t, s, func(conditions deviceutil.Conditions) []string {
+		searched = append(searched, conditions)
+		switch conditions[0] {
+		case deviceutil.EqQuery(ParentID, "iotg-1"):
+			return []string{"iotd-1", "iotd-2"}
+		case deviceutil.GroupQuery("iotg-1"):
+			return []string{"iotd-1", "iotd-2", "iotd-3"}
+		}
+		return nil
+	})
+	ctx := userContext("usr-1", "tenant-1")
+	_, err := s.CreateSubscribe(ctx, &pb.CreateSubscribeRequest{Title: "default"})
+	assert.Nil(t, err)
+	created, err := s.CreateSubscribe(ctx, &pb.CreateSubscribeRequest{Title: "dashboard"})
+	assert.Nil(t, err)
+	_, err = s.SubscribeEntitiesByIDs(ctx, &pb.SubscribeEntitiesByIDsRequest{Id: created.Id, Entities: []string{"iotd-1", "iotd-3"}})
+	assert.Nil(t, err)
+
+	// Without the subgroups only the devices right in the group are removed.
+	resp, err := s.UnsubscribeEntitiesByGroups(ctx, &pb.UnsubscribeEntitiesByGroupsRequest{Id: created.Id, Groups: []string{"iotg-1"}})
+	assert.Nil(t, err)
+	assert.Equal(t, deviceutil.Conditions{deviceutil.EqQuery(ParentID, "iotg-1"), deviceutil.DeviceTypeQuery()}, searched[0])
+	assert.Equal(t, SuccessStatus, resp.Status)
+	assert.Equal(t, map[string]string{
+		"iotd-1": SuccessStatus,
+		"iotd-2": _EntityNotSubscribed,
+	}, entityStatuses(resp.Results))
+	assert.Empty(t, fakeCore.Subscriptions("iotd-1"))
+	assert.Len(t, fakeCore.Subscriptions("iotd-3"), 1)
+
+	resp, err = s.UnsubscribeEntitiesByGroups(ctx, &pb.UnsubscribeEntitiesByGroupsRequest{Id: created.Id, Groups: []string{"iotg-1"}, IncludeSubgroups: true})
+	assert.Nil(t, err)
+	assert.Equal(t, deviceutil.GroupQuery("iotg-1"), searched[1][0])
+	assert.Equal(t, map[string]string{
+		"iotd-1": _EntityNotSubscribed,
+		"iotd-2": _EntityNotSubscribed,
+		"iotd-3": SuccessStatus,
+	}, entityStatuses(resp.Results))
+	assert.Empty(t, fakeCore.Subscriptions("iotd-3"))
+
+	_, err = s.UnsubscribeEntitiesByGroups(ctx, &pb.UnsubscribeEntitiesByGroupsRequest{Id: created.Id, Groups: []string{"iotg-2"}})
+	assert.Equal(t, pb.ErrDeviceNotFound(), err)
+}
+
+func TestUnsubscribeEntitiesByModels(t *testing.T) {
+	s, fakeCore := newTestSubscribeService(t)
+	for _, id := range []string{"iotd-1", "iotd-2", "iotd-3"} {
+		fakeCore.AddEntity(id, "usr-1", nil)
+	}
+	fakeDeviceSearch(t, s, func(conditions deviceutil.Conditions) []string {
+		switch conditions[0] {
+		case deviceutil.TemplateQuery("iotm-1"):
+			return []string{"iotd-1", "iotd-2"}
+		case deviceutil.TemplateQuery("iotm-2"):
+			return []string{"iotd-2", "iotd-3"}
+		}
+		return nil
+	})
+	ctx := userContext("usr-1", "tenant-1")
+	_, err := s.CreateSubscribe(ctx, &pb.CreateSubscribeRequest{Title: "default"})
+	assert.Nil(t, err)
+	created, err := s.CreateSubscribe(ctx, &pb.CreateSubscribeRequest{Title: "dashboard"})
+	assert.Nil(t, err)
+	_, err = s.SubscribeEntitiesByIDs(ctx, &pb.SubscribeEntitiesByIDsRequest{Id: created.Id, Entities: []string{"iotd-1", "iotd-2"}})
+	assert.Nil(t, err)
+
+	// iotd-2 is matched by both models and reported once.
+	resp, err := s.UnsubscribeEntitiesByModels(ctx, &pb.UnsubscribeEntitiesByModelsRequest{Id: created.Id, Models: []string{"iotm-1", "iotm-2"}})
+	assert.Nil(t, err)
+	assert.Equal(t, SuccessStatus, resp.Status)
+	assert.Len(t, resp.Results, 3)
+	assert.Equal(t, map[string]string{
+		"iotd-1": SuccessStatus,
+		"iotd-2": SuccessStatus,
+		"iotd-3": _EntityNotSubscribed,
+	}, entityStatuses(resp.Results))
+	ids, err := (&model.Subscribe{Model: gorm.Model{ID: uint(created.Id)}}).EntityIDs()
+	assert.Nil(t, err)
+	assert.Empty(t, ids)
+	assert.Empty(t, fakeCore.Subscriptions("iotd-1"))
+	assert.Empty(t, fakeCore.Subscriptions("iotd-2"))
+
+	_, err = s.UnsubscribeEntitiesByModels(ctx, &pb.UnsubscribeEntitiesByModelsRequest{Id: created.Id, Models: []string{"iotm-3"}})
+	assert.Equal(t, pb.ErrDeviceNotFound(), err)
+}
+
+// subscribedIDs returns the IDs of the subscribes in the _subscribeAddr of the
+// entity in the fake core.
+func subscribedIDs(t *testing.T, fakeCore *fake.Core, entityID string) []uint {
+	addr, _ := fakeCore.Property(entityID, "sysField._subscribeAddr")
+	addrs, err := subscribeuril.ParseSubscribeAddrs(types.Interface2string(addr))
+	assert.Nil(t, err)
+	ids := make([]uint, 0, len(addrs))
+	for _, a := range addrs {
+		ids = append(ids, a.SubscribeID)
+	}
+	return ids
+}
+
+func TestChangeSubscribed(t *testing.T) {
+	s, fakeCore := newTestSubscribeService(t)
+	for _, id := range []string{"iotd-1", "iotd-2"} {
+		fakeCore.AddEntity(id, "usr-1", nil)
+	}
+	ctx := userContext("usr-1", "tenant-1")
+	_, err := s.CreateSubscribe(ctx, &pb.CreateSubscribeRequest{Title: "default"})
+	assert.Nil(t, err)
+	from, err := s.CreateSubscribe(ctx, &pb.CreateSubscribeRequest{Title: "from"})
+	assert.Nil(t, err)
+	to, err := s.CreateSubscribe(ctx, &pb.CreateSubscribeRequest{Title: "to"})
+	assert.Nil(t, err)
+	_, err = s.SubscribeEntitiesByIDs(ctx, &pb.SubscribeEntitiesByIDsRequest{Id: from.Id, Entities: []string{"iotd-1", "iotd-2"}})
+	assert.Nil(t, err)
+	entityIDs := func(id uint64) []string {
+		ids, err := (&model.Subscribe{Model: gorm.Model{ID: uint(id)}}).EntityIDs()
+		assert.Nil(t, err)
+		return ids
+	}
+
+	_, err = s.ChangeSubscribed(ctx, &pb.ChangeSubscribedRequest{Id: from.Id, TargetId: to.Id})
+	assert.Equal(t, pb.ErrInvalidArgumentSomeFields(), err)
+
+	resp, err := s.ChangeSubscribed(ctx, &pb.ChangeSubscribedRequest{
+		Id: from.Id, TargetId: to.Id, SelectedIds: []string{"iotd-1"}, Mode: _ChangeSubscribedCopy,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, SuccessStatus, resp.Status)
+	assert.ElementsMatch(t, []string{"iotd-1", "iotd-2"}, entityIDs(from.Id))
+	assert.Equal(t, []string{"iotd-1"}, entityIDs(to.Id))
+	assert.Len(t, fakeCore.Subscriptions("iotd-1"), 2)
+	assert.ElementsMatch(t, []uint{uint(from.Id), uint(to.Id)}, subscribedIDs(t, fakeCore, "iotd-1"))
+
+	resp, err = s.ChangeSubscribed(ctx, &pb.ChangeSubscribedRequest{
+		Id: from.Id, TargetId: to.Id, SelectedIds: []string{"iotd-1", "iotd-2", "iotd-3"}, Mode: _ChangeSubscribedMove,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, ErrPartialFailure, resp.Status)
+	assert.Equal(t, map[string]string{
+		"iotd-1": _EntityAlreadySubscribed,
+		"iotd-2": SuccessStatus,
+		"iotd-3": _EntityNotSubscribed,
+	}, entityStatuses(resp.Results))
+	assert.Equal(t, []string{"iotd-1"}, entityIDs(from.Id))
+	assert.ElementsMatch(t, []string{"iotd-1", "iotd-2"}, entityIDs(to.Id))
+	subscriptions := fakeCore.Subscriptions("iotd-2")
+	assert.Len(t, subscriptions, 1)
+	assert.Equal(t, model.CoreSubscriptionID("iotd-2", to.Endpoint), subscriptions[0].ID)
+	assert.Equal(t, []uint{uint(to.Id)}, subscribedIDs(t, fakeCore, "iotd-2"))
+}
+
+func TestChangeSubscribedRollback(t *testing.T) {
+	s, fakeCore := newTestSubscribeService(t)
+	fakeCore.AddEntity("iotd-1", "usr-1", nil)
+	ctx := userContext("usr-1", "tenant-1")
+	_, err := s.CreateSubscribe(ctx, &pb.CreateSubscribeRequest{Title: "default"})
+	assert.Nil(t, err)
+	from, err := s.CreateSubscribe(ctx, &pb.CreateSubscribeRequest{Title: "from"})
+	assert.Nil(t, err)
+	to, err := s.CreateSubscribe(ctx, &pb.CreateSubscribeRequest{Title: "to"})
+	assert.Nil(t, err)
+	_, err = s.SubscribeEntitiesByIDs(ctx, &pb.SubscribeEntitiesByIDsRequest{Id: from.Id, Entities: []string{"iotd-1"}})
+	assert.Nil(t, err)
+	entityIDs := func(id uint64) []string {
+		ids, err := (&model.Subscribe{Model: gorm.Model{ID: uint(id)}}).EntityIDs()
+		assert.Nil(t, err)
+		return ids
+	}
+
+	// Deleting the source core subscription fails after the target one has
+	// been created and the target address added.
+	fakeCore.SetError(fake.MethodUnsubscribe, errors.New("core unavailable"))
+	_, err = s.ChangeSubscribed(ctx, &pb.ChangeSubscribedRequest{
+		Id: from.Id, TargetId: to.Id, SelectedIds: []string{"iotd-1"}, Rollback: true,
+	})
+	assert.Equal(t, pb.ErrInternalError(), err)
+	assert.Equal(t, []string{"iotd-1"}, entityIDs(from.Id))
+	assert.Empty(t, entityIDs(to.Id))
+	assert.Equal(t, []uint{uint(from.Id)}, subscribedIDs(t, fakeCore, "iotd-1"))
+
+	// Without rollback the memberships are moved though core is not in sync.
+	resp, err := s.ChangeSubscribed(ctx, &pb.ChangeSubscribedRequest{
+		Id: from.Id, TargetId: to.Id, SelectedIds: []string{"iotd-1"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, ErrPartialFailure, resp.Status)
+	assert.Len(t, resp.Results, 1)
+	assert.Equal(t, _EntityCoreOutOfSync, resp.Results[0].Status)
+	assert.NotEmpty(t, resp.Results[0].Message)
+	assert.Empty(t, entityIDs(from.Id))
+	assert.Equal(t, []string{"iotd-1"}, entityIDs(to.Id))
+
+	fakeCore.SetError(fake.MethodUnsubscribe, nil)
+	_, err = s.ChangeSubscribed(ctx, &pb.ChangeSubscribedRequest{
+		Id: to.Id, TargetId: from.Id, SelectedIds: []string{"iotd-1"}, Rollback: true,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"iotd-1"}, entityIDs(from.Id))
+	assert.Empty(t, entityIDs(to.Id))
+}
+
+func TestListSubscribeEntitiesCached(t *testing.T) {
+	s, fakeCore := newTestSubscribeService(t)
+	for _, id := range []string{"iotd-1", "iotd-2", "iotd-3"} {
+		fakeCore.AddEntity(id, "usr-1", nil)
+	}
+	searched := make([]deviceutil.Conditions, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := deviceutil.SearchRequest{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&req))
+		searched = append(searched, req.Conditions)
+		items := make([]map[string]interface{}, 0)
+		for _, id := range strings.Split(req.Conditions[0].Value, ",") {
+			items = append(items, map[string]interface{}{
+				"id":         id,
+				"properties": map[string]interface{}{"basicInfo": map[string]interface{}{"name": "device " + id}},
+			})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"code": _searchSuccessCode,
+			"data": map[string]interface{}{"items": items, "total": len(items)},
+		})
+	}))
+	s.devices.EntitySearch = deviceutil.Service(server.URL)
+	t.Cleanup(server.Close)
+	ctx := userContext("usr-1", "tenant-1")
+	_, err := s.CreateSubscribe(ctx, &pb.CreateSubscribeRequest{Title: "default"})
+	assert.Nil(t, err)
+	created, err := s.CreateSubscribe(ctx, &pb.CreateSubscribeRequest{Title: "dashboard"})
+	assert.Nil(t, err)
+	_, err = s.SubscribeEntitiesByIDs(ctx, &pb.SubscribeEntitiesByIDsRequest{Id: created.Id, Entities: []string{"iotd-1", "iotd-2", "iotd-3"}})
+	assert.Nil(t, err)
+
+	list := func(ctx context.Context) []string {
+		resp, err := s.ListSubscribeEntities(ctx, &pb.ListSubscribeEntitiesRequest{Id: created.Id, PageNum: 1, PageSize: 2})
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(3), resp.Total)
+		names := make([]string, 0, len(resp.Data))
+		for _, e := range resp.Data {
+			names = append(names, e.Name)
+		}
+		return names
+	}
+	assert.Equal(t, []string{"device iotd-1", "device iotd-2"}, list(ctx))
+	assert.Len(t, searched, 1)
+	assert.Equal(t, deviceutil.InQuery("id", "iotd-1", "iotd-2"), searched[0][0])
+
+	// The metadata is cached for the user.
+	assert.Equal(t, []string{"device iotd-1", "device iotd-2"}, list(ctx))
+	assert.Len(t, searched, 1)
+
+	// Another tenant does not see the metadata cached for the first one.
+	_, ok := s.entities.Get(deviceutil.CacheScope("tenant-2", "usr-1"), "iotd-1")
+	assert.False(t, ok)
+
+	// A change of the name invalidates the cached metadata.
+	s.entities.Invalidate("iotd-2", map[string]interface{}{"basicInfo.name": "renamed"})
+	assert.Equal(t, []string{"device iotd-1", "device iotd-2"}, list(ctx))
+	assert.Len(t, searched, 2)
+	assert.Equal(t, deviceutil.InQuery("id", "iotd-2"), searched[1][0])
+}
+
+func TestSubscribeOfAnotherUser(t *testing.T) {
+	s, fakeCore := newTestSubscribeService(t)
+	fakeCore.AddEntity("iotd-1", "usr-2", nil)
+	owner := userContext("usr-1", "tenant-1")
+	_, err := s.CreateSubscribe(owner, &pb.CreateSubscribeRequest{Title: "default"})
+	assert.Nil(t, err)
+	created, err := s.CreateSubscribe(owner, &pb.CreateSubscribeRequest{Title: "dashboard"})
+	assert.Nil(t, err)
+
+	other := userContext("usr-2", "tenant-1")
+	_, err = s.GetSubscribe(other, &pb.GetSubscribeRequest{Id: created.Id})
+	assert.NotNil(t, err)
+	_, err = s.SubscribeEntitiesByIDs(other, &pb.SubscribeEntitiesByIDsRequest{Id: created.Id, Entities: []string{"iotd-1"}})
+	assert.NotNil(t, err)
+	_, err = s.DeleteSubscribe(other, &pb.DeleteSubscribeRequest{Id: created.Id})
+	assert.NotNil(t, err)
+	assert.Empty(t, fakeCore.Subscriptions("iotd-1"))
+
+	got, err := s.GetSubscribe(owner, &pb.GetSubscribeRequest{Id: created.Id})
+	assert.Nil(t, err)
+	assert.Equal(t, "dashboard", got.Title)
+}
@@ -17,152 +17,611 @@ limitations under the License.
 package service
 
 import (
+	"context"
 	"encoding/json"
+	"net"
 	"net/http"
+	"net/url"
+	"strings"
 	"sync"
+	"time"
 
 	go_restful "github.com/emicklei/go-restful"
-	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	topicpb "github.com/tkeel-io/core-broker/api/topic/v1"
+	"github.com/tkeel-io/core-broker/pkg/auth"
+	"github.com/tkeel-io/core-broker/pkg/config"
 	"github.com/tkeel-io/core-broker/pkg/core"
+	"github.com/tkeel-io/core-broker/pkg/util"
 
 	"github.com/gorilla/websocket"
 	"github.com/tkeel-io/core-broker/pkg/types"
 	"github.com/tkeel-io/kit/log"
 )
 
+// maxWsEntities bounds the entities one connection watches.
+const maxWsEntities = 1000
+
+// wsAuthProtocol is the WebSocket subprotocol offered along with the access
+// token by browsers, which cannot set headers.
+const wsAuthProtocol = "tkeel-auth"
+
+var (
+	errWsNotSubscribed   = errors.New("entity is not subscribed")
+	errWsTooManyEntities = errors.New("too many entities")
+	errWsForbidden       = errors.New("entity is not owned by the user or tenant")
+)
+
 type EntityService struct {
-	msgChanMap map[string]map[string]chan []byte // entityID  clientID msgChan
-	coreClient *core.Client
-	locker     sync.RWMutex
+	entities      map[string]*wsEntity // entityID  entity
+	coreClient    core.Client
+	authenticator auth.TokenAuthenticator
+	registry      *WsRegistry
+	upgrader      websocket.Upgrader
+	conf          config.WebSocket
+	locker        sync.RWMutex
+	// entityLocks serializes subscribing and unsubscribing an entity.
+	entityLocks *util.KeyedMutex
+	// shutdown is closed to close the connections, conns tracks them.
+	shutdown     chan struct{}
+	shutdownOnce sync.Once
+	conns        sync.WaitGroup
+}
+
+// NewEntityService serves the entity WebSocket, the connections which did not
+// pass the tKeel gateway are authenticated by the access token they carry.
+// The core subscriptions are recorded in the registry unless it is nil.
+func NewEntityService(coreClient core.Client, authenticator auth.TokenAuthenticator, registry *WsRegistry, conf config.WebSocket) *EntityService {
+	defaults := config.Default().WebSocket
+	if conf.QueueSize <= 0 {
+		conf.QueueSize = defaults.QueueSize
+	}
+	if conf.OverflowPolicy == "" {
+		conf.OverflowPolicy = defaults.OverflowPolicy
+	}
+	for _, d := range []struct{ value, def *time.Duration }{
+		{&conf.PingInterval, &defaults.PingInterval},
+		{&conf.IdleTimeout, &defaults.IdleTimeout},
+		{&conf.WriteTimeout, &defaults.WriteTimeout},
+		{&conf.DrainTimeout, &defaults.DrainTimeout},
+	} {
+		if *d.value <= 0 {
+			*d.value = *d.def
+		}
+	}
+	s := &EntityService{
+		entities:      make(map[string]*wsEntity),
+		coreClient:    coreClient,
+		authenticator: authenticator,
+		registry:      registry,
+		entityLocks:   util.NewKeyedMutex(),
+		upgrader: websocket.Upgrader{
+			CheckOrigin:  checkOrigin(conf.AllowedOrigins),
+			Subprotocols: []string{wsAuthProtocol},
+		},
+		conf:     conf,
+		shutdown: make(chan struct{}),
+	}
+	if registry != nil {
+		registry.resubscribe = s.resubscribe
+	}
+	return s
 }
 
-func NewEntityService() *EntityService {
-	msgChanMap := make(map[string]map[string]chan []byte)
-	return &EntityService{msgChanMap: msgChanMap}
+// wsEntity is an entity watched by WebSocket clients, its core subscription
+// is owned by the user whose client watched it first. The clients are the
+// references to the entity.
+type wsEntity struct {
+	clients map[string]*wsClient // clientID client
+	userID  string
+	// release unsubscribes the entity once the grace period after its last
+	// client left is over, a client coming back stops it.
+	release *time.Timer
 }
 
-func (s *EntityService) CoreClient() *core.Client {
-	if s.coreClient != nil {
-		return s.coreClient
+func (s *EntityService) Run() {
+	for {
+		s.deliver(<-types.MsgChan)
 	}
+}
 
-	client, err := core.NewCoreClient()
-	if err != nil {
-		log.Fatal(err)
+// deliver sends the property update to the clients of its entity.
+func (s *EntityService) deliver(msg *topicpb.TopicEventRequest) {
+	log.Debugf("event msg data: %+v", msg.Data.AsInterface())
+	kv, ok := msg.Data.AsInterface().(map[string]interface{})
+	if !ok {
+		return
+	}
+	entityID := types.GetEntityID(types.Interface2string(kv["id"]))
+	properties, _ := kv["properties"].(map[string]interface{})
+	s.locker.RLock()
+	for _, client := range s.entities[entityID].clientsOrNil() {
+		client.update(entityID, properties)
+	}
+	s.locker.RUnlock()
+}
+
+func (e *wsEntity) clientsOrNil() map[string]*wsClient {
+	if e == nil {
 		return nil
 	}
-	s.coreClient = client
-	return s.coreClient
+	return e.clients
 }
 
-func (s *EntityService) Run() {
-	var entityID string
-	var msgData []byte
-	for {
-		msg := <-types.MsgChan
-		log.Debugf("event msg data: %+v", msg.Data.AsInterface())
-		log.Debugf("event msg data: %#v", msg.Data.AsInterface())
-		log.Debugf("event msg data type: %T", msg.Data.AsInterface())
-		switch kv := msg.Data.AsInterface().(type) {
-		case map[string]interface{}:
-			subID := types.Interface2string(kv["id"])
-			entityID = types.GetEntityID(subID)
-			msgData, _ = json.Marshal(kv["properties"])
+// checkOrigin allows requests without origin, like the ones of other
+// services, the origin of the broker itself and the allowed origins.
+func checkOrigin(allowed []string) func(r *http.Request) bool {
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			return true
 		}
-		s.locker.RLock()
-		if clientMsgChan, ok := s.msgChanMap[entityID]; ok {
-			for _, msgChan := range clientMsgChan {
-				msgChan <- msgData
+		if u, err := url.Parse(origin); err == nil && strings.EqualFold(u.Host, r.Host) {
+			return true
+		}
+		for _, a := range allowed {
+			if a == "*" || strings.EqualFold(strings.TrimSuffix(a, "/"), origin) {
+				return true
 			}
 		}
-		s.locker.RUnlock()
+		return false
 	}
 }
 
-var upgrader = websocket.Upgrader{CheckOrigin: func(r *http.Request) bool {
-	return true
-}}
+// authenticate resolves the user of the X-Tkeel-Auth header set by the
+// gateway, or else of the access token in the Authorization header, the
+// token query parameter or the subprotocols.
+func (s *EntityService) authenticate(r *http.Request) (auth.User, error) {
+	user, err := auth.GetRequestUser(r)
+	if !errors.Is(err, auth.ErrNotFound) {
+		return user, err
+	}
+	token := r.Header.Get("Authorization")
+	if token == "" {
+		token = r.URL.Query().Get("token")
+	}
+	if protocols := websocket.Subprotocols(r); token == "" && contains(protocols, wsAuthProtocol) {
+		for _, protocol := range protocols {
+			if protocol != wsAuthProtocol {
+				token = protocol
+				break
+			}
+		}
+	}
+	if token == "" || s.authenticator == nil {
+		return user, auth.ErrNotFound
+	}
+	return s.authenticator.Authenticate(r.Context(), token)
+}
+
+// authorize checks that the entity is owned by the user or by the tenant of
+// the user, and returns its current properties.
+func (s *EntityService) authorize(ctx context.Context, user auth.User, entityID string) (map[string]interface{}, error) {
+	entity, err := s.coreClient.GetDeviceEntity(ctx, entityID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get entity")
+	}
+	if entity.Owner == user.ID || entity.Properties.SysField.Owner == user.ID {
+		return entity.PropertyValues, nil
+	}
+	if tenantID := entity.Properties.SysField.TenantID; tenantID != "" && tenantID == user.TenantID {
+		return entity.PropertyValues, nil
+	}
+	return nil, errWsForbidden
+}
 
-func (s *EntityService) handleRequest(c *websocket.Conn, stopChan chan struct{}, msgChan chan []byte) {
-	clientID := uuid.New().String()
-	var entityID string
+func (s *EntityService) handleRequest(ctx context.Context, c *websocket.Conn, client *wsClient) {
+	idle := func(string) error {
+		return c.SetReadDeadline(time.Now().Add(s.conf.IdleTimeout))
+	}
+	c.SetPongHandler(idle)
 	for {
+		if err := idle(""); err != nil {
+			log.Error("ws set read deadline err:", err)
+		}
 		_, p, err := c.ReadMessage()
 		if err != nil {
-			s.locker.Lock()
-			defer s.locker.Unlock()
-			if _, ok := s.msgChanMap[entityID]; ok {
-				delete(s.msgChanMap[entityID], clientID)
-				if len(s.msgChanMap[entityID]) == 0 {
-					subID := types.SubscriptionIDByJoin(entityID, types.Topic)
-					if err := s.CoreClient().Unsubscribe(subID, "admin"); err != nil {
-						log.Error("call unsubscribe entity error:", err)
-					}
-					delete(s.msgChanMap, entityID)
-				}
+			var netErr net.Error
+			if errors.As(err, &netErr) && netErr.Timeout() {
+				client.close(c, websocket.CloseNormalClosure, "idle timeout")
 			}
-			close(stopChan)
+			s.unsubscribe(client, client.entityIDs())
 			return
 		}
 
 		wsReq := types.WsRequest{}
-		err = json.Unmarshal(p, &wsReq)
-		if err != nil || wsReq.ID == "" {
+		if err = json.Unmarshal(p, &wsReq); err != nil {
 			log.Error(err)
+			client.reply(&types.WsResponse{Type: types.WsError, Error: "invalid request"})
 			continue
 		}
+		resp, snapshots := s.handle(ctx, client, &wsReq)
+		if resp != nil {
+			client.reply(resp)
+		}
+		s.sendSnapshots(client, snapshots)
+	}
+}
+
+// handle applies the request and returns the reply, nil for legacy requests,
+// and the snapshots of the entities subscribed by it, which follow the reply.
+func (s *EntityService) handle(ctx context.Context, client *wsClient, req *types.WsRequest) (*types.WsResponse, []wsSnapshot) {
+	if req.Type == "" {
+		if req.ID == "" {
+			return nil, nil
+		}
+		client.setLegacy(true)
+		previous := make([]string, 0)
+		for _, id := range client.entityIDs() {
+			if id != req.ID {
+				previous = append(previous, id)
+			}
+		}
+		s.unsubscribe(client, previous)
+		failed, snapshots := s.subscribe(ctx, client, []string{req.ID}, nil)
+		for _, failure := range failed {
+			log.Errorf("subscribe entity %s err: %s", failure.ID, failure.Error)
+		}
+		return nil, snapshots
+	}
 
-		entityIDTemp := wsReq.ID
-		if entityID == "" && entityIDTemp != "" {
-			entityID = entityIDTemp
-		} else if entityID != entityIDTemp {
-			s.locker.Lock()
-			delete(s.msgChanMap[entityID], clientID)
-			s.locker.Unlock()
-			entityID = entityIDTemp
+	client.setLegacy(false)
+	resp := &types.WsResponse{Type: types.WsAck, RequestID: req.RequestID}
+	var snapshots []wsSnapshot
+	switch req.Type {
+	case types.WsSubscribe, types.WsUnsubscribe:
+		ids := uniqueEntityIDs(req.IDs)
+		if len(ids) == 0 {
+			resp.Type, resp.Error = types.WsError, "ids is empty"
+			return resp, nil
 		}
-		if _, ok := s.msgChanMap[entityID]; !ok {
-			s.locker.Lock()
-			s.msgChanMap[entityID] = make(map[string]chan []byte)
-			s.locker.Unlock()
+		if req.Type == types.WsSubscribe {
+			resp.Failed, snapshots = s.subscribe(ctx, client, ids, req.Fields)
+		} else {
+			resp.Failed = s.unsubscribe(client, ids)
+		}
+		resp.IDs = succeeded(ids, resp.Failed)
+		if len(resp.Failed) != 0 {
+			resp.Type, resp.Error = types.WsError, "some entities failed"
+		}
+	case types.WsList:
+		resp.Type = types.WsList
+		resp.IDs = client.entityIDs()
+	default:
+		resp.Type, resp.Error = types.WsError, "unknown type "+req.Type
+	}
+	return resp, snapshots
+}
 
-			subID := types.SubscriptionIDByJoin(entityID, types.Topic)
-			if err := s.CoreClient().Subscribe(subID, entityID, types.Topic, "admin"); err != nil {
-				log.Error("call subscribing to core err:", err)
+// wsSnapshot is the current properties of a subscribed entity, or the error
+// getting them.
+type wsSnapshot struct {
+	entityID   string
+	properties map[string]interface{}
+	err        error
+}
+
+// sendSnapshots sends the snapshots of the entities, the updates received
+// since they were subscribed follow them.
+func (s *EntityService) sendSnapshots(client *wsClient, snapshots []wsSnapshot) {
+	for _, snapshot := range snapshots {
+		client.snapshot(snapshot.entityID, snapshot.properties, snapshot.err)
+	}
+}
+
+// subscribe adds the client to the entities with the fields, the first
+// client of an entity subscribes it in core. Entities the client already
+// has succeed with their fields replaced. It returns the failures and the
+// snapshots of the entities subscribed, the properties read when authorizing
+// the client.
+func (s *EntityService) subscribe(ctx context.Context, client *wsClient, entityIDs []string, fields []string) ([]types.WsFailure, []wsSnapshot) {
+	failed := make([]types.WsFailure, 0)
+	snapshots := make([]wsSnapshot, 0, len(entityIDs))
+	for _, entityID := range entityIDs {
+		if client.subscribed(entityID) {
+			client.watch(entityID, fields)
+			snapshot := wsSnapshot{entityID: entityID}
+			entity, err := s.coreClient.GetDeviceEntity(ctx, entityID)
+			if err != nil {
+				log.Errorf("get snapshot of entity %s err: %v", entityID, err)
+				snapshot.err = err
+			} else {
+				snapshot.properties = entity.PropertyValues
 			}
+			snapshots = append(snapshots, snapshot)
+			continue
+		}
+		if client.count() >= maxWsEntities {
+			failed = append(failed, types.WsFailure{ID: entityID, Error: errWsTooManyEntities.Error()})
+			continue
 		}
-		s.locker.Lock()
-		s.msgChanMap[entityID][clientID] = msgChan
+		properties, err := s.authorize(ctx, client.user, entityID)
+		if err != nil {
+			log.Errorf("authorize user %s for entity %s err: %v", client.user.ID, entityID, err)
+			failed = append(failed, types.WsFailure{ID: entityID, Error: err.Error()})
+			continue
+		}
+		client.watch(entityID, fields)
+		if err = s.addClient(entityID, client); err != nil {
+			log.Error("call subscribing to core err:", err)
+			client.unwatch(entityID)
+			failed = append(failed, types.WsFailure{ID: entityID, Error: err.Error()})
+			continue
+		}
+		snapshots = append(snapshots, wsSnapshot{entityID: entityID, properties: properties})
+	}
+	return failed, snapshots
+}
+
+// unsubscribe removes the client from the entities, the last client of an
+// entity unsubscribes it in core.
+func (s *EntityService) unsubscribe(client *wsClient, entityIDs []string) []types.WsFailure {
+	failed := make([]types.WsFailure, 0)
+	for _, entityID := range entityIDs {
+		if !client.subscribed(entityID) {
+			failed = append(failed, types.WsFailure{ID: entityID, Error: errWsNotSubscribed.Error()})
+			continue
+		}
+		client.unwatch(entityID)
+		s.removeClient(entityID, client)
+	}
+	return failed
+}
+
+// addClient adds a reference of the client to the entity, the first one
+// subscribes the entity in core unless it is still subscribed because its
+// last client left within the grace period.
+func (s *EntityService) addClient(entityID string, client *wsClient) error {
+	unlock := s.entityLocks.Lock(entityID)
+	defer unlock()
+	s.locker.Lock()
+	if entity, ok := s.entities[entityID]; ok {
+		if entity.release != nil {
+			entity.release.Stop()
+			entity.release = nil
+		}
+		entity.clients[client.id] = client
 		s.locker.Unlock()
+		return nil
+	}
+	s.locker.Unlock()
+
+	subID := types.SubscriptionIDByJoin(entityID, types.Topic)
+	if err := s.coreClient.Subscribe(context.Background(), subID, entityID, types.Topic, client.user.ID); err != nil {
+		return err
+	}
+	s.registry.add(subID, entityID, client.user.ID)
+	s.locker.Lock()
+	s.entities[entityID] = &wsEntity{clients: map[string]*wsClient{client.id: client}, userID: client.user.ID}
+	s.locker.Unlock()
+	return nil
+}
+
+// resubscribe subscribes the watched entities in core again, after another
+// instance removed their core subscriptions.
+func (s *EntityService) resubscribe() {
+	s.locker.RLock()
+	entityIDs := make([]string, 0, len(s.entities))
+	for entityID := range s.entities {
+		entityIDs = append(entityIDs, entityID)
+	}
+	s.locker.RUnlock()
+	for _, entityID := range entityIDs {
+		s.resubscribeEntity(entityID)
+	}
+}
+
+// resubscribeEntity subscribes the entity in core again unless its last
+// client left meanwhile and it was unsubscribed.
+func (s *EntityService) resubscribeEntity(entityID string) {
+	unlock := s.entityLocks.Lock(entityID)
+	defer unlock()
+	s.locker.RLock()
+	entity, ok := s.entities[entityID]
+	var userID string
+	if ok {
+		userID = entity.userID
+	}
+	s.locker.RUnlock()
+	if !ok {
+		return
+	}
+	subID := types.SubscriptionIDByJoin(entityID, types.Topic)
+	if err := s.coreClient.Subscribe(context.Background(), subID, entityID, types.Topic, userID); err != nil {
+		log.Error("call subscribing to core err:", err)
+		return
 	}
+	s.registry.add(subID, entityID, userID)
+}
+
+// removeClient removes the reference of the client to the entity, the
+// entity is unsubscribed in core once the grace period after its last
+// client left is over, or right away while shutting down.
+func (s *EntityService) removeClient(entityID string, client *wsClient) {
+	unlock := s.entityLocks.Lock(entityID)
+	defer unlock()
+	s.locker.Lock()
+	entity, ok := s.entities[entityID]
+	if !ok {
+		s.locker.Unlock()
+		return
+	}
+	delete(entity.clients, client.id)
+	if len(entity.clients) != 0 || entity.release != nil {
+		s.locker.Unlock()
+		return
+	}
+	if grace := s.conf.UnsubscribeGrace; grace > 0 && !s.shuttingDown() {
+		var release *time.Timer
+		release = time.AfterFunc(grace, func() { s.release(entityID, entity, release) })
+		entity.release = release
+		s.locker.Unlock()
+		return
+	}
+	delete(s.entities, entityID)
+	s.locker.Unlock()
+	s.unsubscribeCore(entityID, entity.userID)
+}
+
+// release unsubscribes the entity at the end of the grace period unless a
+// client came back meanwhile.
+func (s *EntityService) release(entityID string, entity *wsEntity, release *time.Timer) {
+	unlock := s.entityLocks.Lock(entityID)
+	defer unlock()
+	s.locker.Lock()
+	if s.entities[entityID] != entity || entity.release != release {
+		s.locker.Unlock()
+		return
+	}
+	delete(s.entities, entityID)
+	s.locker.Unlock()
+	s.unsubscribeCore(entityID, entity.userID)
+}
+
+// releaseAll unsubscribes the entities waiting for the end of their grace
+// period.
+func (s *EntityService) releaseAll() {
+	s.locker.RLock()
+	pending := make(map[string]*wsEntity)
+	for entityID, entity := range s.entities {
+		if entity.release != nil {
+			pending[entityID] = entity
+		}
+	}
+	s.locker.RUnlock()
+	for entityID, entity := range pending {
+		s.locker.RLock()
+		release := entity.release
+		s.locker.RUnlock()
+		if release != nil && release.Stop() {
+			s.release(entityID, entity, release)
+		}
+	}
+}
+
+func (s *EntityService) unsubscribeCore(entityID, userID string) {
+	subID := types.SubscriptionIDByJoin(entityID, types.Topic)
+	if err := s.coreClient.Unsubscribe(context.Background(), subID, userID); err != nil {
+		log.Error("call unsubscribe entity error:", err)
+		return
+	}
+	s.registry.remove(subID)
 }
 
 func (s *EntityService) GetEntity(req *go_restful.Request, resp *go_restful.Response) {
-	c, err := upgrader.Upgrade(resp, req.Request, nil)
+	if !s.upgrader.CheckOrigin(req.Request) {
+		log.Warnf("ws origin %s is not allowed", req.Request.Header.Get("Origin"))
+		http.Error(resp, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+	user, err := s.authenticate(req.Request)
 	if err != nil {
-		log.Fatal(err)
+		log.Error("ws authenticate err:", err)
+		http.Error(resp, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+	if !s.track() {
+		http.Error(resp, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
+		return
+	}
+	defer s.conns.Done()
+	c, err := s.upgrader.Upgrade(resp, req.Request, nil)
+	if err != nil {
+		// The upgrader has replied with the error.
+		log.Error("ws upgrade err:", err)
+		return
 	}
 
-	defer c.Close()
-
+	client := newWsClient(user, s.conf)
 	stopChan := make(chan struct{})
-	msgChan := make(chan []byte)
+	go func() {
+		s.handleRequest(req.Request.Context(), c, client)
+		close(stopChan)
+	}()
+	client.writeLoop(c, stopChan, s.shutdown)
+	// Closing the connection ends the reader, which unsubscribes the client.
+	c.Close()
+	<-stopChan
+}
+
+// track counts the connection unless the service is shutting down.
+func (s *EntityService) track() bool {
+	s.locker.Lock()
+	defer s.locker.Unlock()
+	select {
+	case <-s.shutdown:
+		return false
+	default:
+		s.conns.Add(1)
+		return true
+	}
+}
+
+// Shutdown refuses new connections and closes the open ones once their
+// queued messages are written, it waits for them until ctx is done.
+func (s *EntityService) Shutdown(ctx context.Context) error {
+	s.locker.Lock()
+	s.shutdownOnce.Do(func() { close(s.shutdown) })
+	s.locker.Unlock()
+	done := make(chan struct{})
+	go func() {
+		s.conns.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		s.releaseAll()
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
 
-	defer close(msgChan)
+func (s *EntityService) shuttingDown() bool {
+	select {
+	case <-s.shutdown:
+		return true
+	default:
+		return false
+	}
+}
 
-	go s.handleRequest(c, stopChan, msgChan)
+func uniqueEntityIDs(ids []string) []string {
+	seen := make(map[string]struct{}, len(ids))
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok || id == "" {
+			continue
+		}
+		seen[id] = struct{}{}
+		out = append(out, id)
+	}
+	return out
+}
 
-	for {
-		select {
-		case msg := <-msgChan:
-			err = c.WriteMessage(websocket.TextMessage, msg)
-			if err != nil {
-				return
-			}
+// succeeded returns the ids which are not failed.
+func succeeded(ids []string, failed []types.WsFailure) []string {
+	if len(failed) == 0 {
+		return ids
+	}
+	skip := make(map[string]struct{}, len(failed))
+	for _, f := range failed {
+		skip[f.ID] = struct{}{}
+	}
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := skip[id]; !ok {
+			out = append(out, id)
+		}
+	}
+	return out
+}
 
-		case <-stopChan:
-			log.Info("ws stop")
-			return
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
 		}
 	}
+	return false
 }
@@ -0,0 +1,357 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/tkeel-io/core-broker/pkg/auth"
+	"github.com/tkeel-io/core-broker/pkg/config"
+	"github.com/tkeel-io/core-broker/pkg/metrics"
+	"github.com/tkeel-io/core-broker/pkg/types"
+	"github.com/tkeel-io/kit/log"
+)
+
+// maxWsPending bounds the updates of an entity held back until its snapshot
+// is sent, the oldest are dropped beyond it.
+const maxWsPending = 256
+
+// wsClient is a WebSocket connection. Its messages are queued for its own
+// writer, so that a slow connection does not hold back the others.
+type wsClient struct {
+	id   string
+	user auth.User
+	send chan wsMessage
+	// stopped is set once the writer has exited, the messages are no longer
+	// queued nor the metrics of the client updated then.
+	stopMu  sync.RWMutex
+	stopped bool
+	// overflow is closed when the full queue disconnects the client.
+	overflow     chan struct{}
+	overflowOnce sync.Once
+	conf         config.WebSocket
+
+	mu   sync.Mutex
+	seq  uint64
+	subs map[string]*wsSubscription // entityID subscription
+	// legacy clients only send requests without type and receive the bare
+	// properties of their entity.
+	legacy bool
+}
+
+// wsSubscription is an entity watched by a client.
+type wsSubscription struct {
+	fields []string
+	// waiting is set until the snapshot of the entity is sent, the updates
+	// are held back in pending meanwhile so that they follow it.
+	waiting bool
+	pending []map[string]interface{}
+}
+
+// wsMessage is a queued message and when it was queued.
+type wsMessage struct {
+	data   []byte
+	queued time.Time
+}
+
+func newWsClient(user auth.User, conf config.WebSocket) *wsClient {
+	return &wsClient{
+		id:       uuid.New().String(),
+		user:     user,
+		send:     make(chan wsMessage, conf.QueueSize),
+		overflow: make(chan struct{}),
+		conf:     conf,
+		subs:     make(map[string]*wsSubscription),
+	}
+}
+
+// write queues the message without blocking, the overflow policy applies
+// if the queue is full. It reports whether the message was queued.
+func (c *wsClient) write(data []byte) bool {
+	c.stopMu.RLock()
+	defer c.stopMu.RUnlock()
+	if c.stopped {
+		return false
+	}
+	msg := wsMessage{data: data, queued: time.Now()}
+	for {
+		select {
+		case c.send <- msg:
+			metrics.CollectorWsQueueLength.WithLabelValues(types.Topic).Observe(float64(len(c.send)))
+			return true
+		default:
+		}
+		switch c.conf.OverflowPolicy {
+		case config.OverflowDropNewest:
+			metrics.CollectorWsDropped.WithLabelValues(types.Topic).Inc()
+			return false
+		case config.OverflowDisconnect:
+			c.overflowOnce.Do(func() { close(c.overflow) })
+			return false
+		default:
+			select {
+			case <-c.send:
+				metrics.CollectorWsDropped.WithLabelValues(types.Topic).Inc()
+			default:
+			}
+		}
+	}
+}
+
+// writeLoop writes the queued messages and the pings to the connection
+// until stop is closed, a write fails, the queue overflows with the
+// disconnect policy or the service shuts down.
+func (c *wsClient) writeLoop(conn *websocket.Conn, stop, shutdown <-chan struct{}) {
+	defer c.stop()
+	ping := time.NewTicker(c.conf.PingInterval)
+	defer ping.Stop()
+	for {
+		select {
+		case msg := <-c.send:
+			if err := c.writeMessage(conn, msg, time.Now().Add(c.conf.WriteTimeout)); err != nil {
+				log.Error("ws write err:", err)
+				return
+			}
+		case <-ping.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(c.conf.WriteTimeout)); err != nil {
+				log.Error("ws ping err:", err)
+				return
+			}
+		case <-c.overflow:
+			log.Warnf("ws client %s is disconnected, its queue is full", c.id)
+			c.close(conn, websocket.CloseTryAgainLater, "queue is full")
+			return
+		case <-shutdown:
+			c.drain(conn)
+			c.close(conn, websocket.CloseGoingAway, "server is shutting down")
+			return
+		case <-stop:
+			log.Info("ws stop")
+			return
+		}
+	}
+}
+
+func (c *wsClient) writeMessage(conn *websocket.Conn, msg wsMessage, deadline time.Time) error {
+	metrics.CollectorWsQueueLength.WithLabelValues(types.Topic).Observe(float64(len(c.send)))
+	metrics.CollectorWsLag.WithLabelValues(types.Topic).Observe(time.Since(msg.queued).Seconds())
+	if err := conn.SetWriteDeadline(deadline); err != nil {
+		return err
+	}
+	return conn.WriteMessage(websocket.TextMessage, msg.data)
+}
+
+// drain writes the queued messages within the drain timeout.
+func (c *wsClient) drain(conn *websocket.Conn) {
+	deadline := time.Now().Add(c.conf.DrainTimeout)
+	for len(c.send) > 0 && time.Now().Before(deadline) {
+		if err := c.writeMessage(conn, <-c.send, deadline); err != nil {
+			log.Error("ws drain err:", err)
+			return
+		}
+	}
+}
+
+// close sends the close frame with the code and reason.
+func (c *wsClient) close(conn *websocket.Conn, code int, reason string) {
+	msg := websocket.FormatCloseMessage(code, reason)
+	if err := conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(c.conf.WriteTimeout)); err != nil {
+		log.Debugf("ws close client %s err: %v", c.id, err)
+	}
+}
+
+// stop marks the writer as exited, the messages written afterwards are
+// neither queued nor counted.
+func (c *wsClient) stop() {
+	c.stopMu.Lock()
+	c.stopped = true
+	c.stopMu.Unlock()
+}
+
+func (c *wsClient) entityIDs() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ids := make([]string, 0, len(c.subs))
+	for id := range c.subs {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func (c *wsClient) subscribed(entityID string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.subs[entityID]
+	return ok
+}
+
+func (c *wsClient) count() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.subs)
+}
+
+func (c *wsClient) setLegacy(legacy bool) {
+	c.mu.Lock()
+	c.legacy = legacy
+	c.mu.Unlock()
+}
+
+// watch adds the entity with the fields, or changes its fields, and holds
+// its updates back until snapshot is called.
+func (c *wsClient) watch(entityID string, fields []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if sub, ok := c.subs[entityID]; ok {
+		sub.fields = fields
+		sub.waiting = true
+		return
+	}
+	c.subs[entityID] = &wsSubscription{fields: fields, waiting: true}
+}
+
+func (c *wsClient) unwatch(entityID string) {
+	c.mu.Lock()
+	delete(c.subs, entityID)
+	c.mu.Unlock()
+}
+
+// update sends the selected properties of the update of the entity.
+func (c *wsClient) update(entityID string, properties map[string]interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	sub, ok := c.subs[entityID]
+	if !ok {
+		return
+	}
+	if sub.waiting {
+		if len(sub.pending) == maxWsPending {
+			log.Warnf("ws client %s drops an update of entity %s waiting for its snapshot", c.id, entityID)
+			sub.pending = sub.pending[1:]
+		}
+		sub.pending = append(sub.pending, properties)
+		return
+	}
+	c.sendProperties(types.WsData, entityID, sub.fields, properties)
+}
+
+// snapshot sends the selected current properties of the entity, or the
+// error getting them, followed by the updates held back meanwhile.
+func (c *wsClient) snapshot(entityID string, properties map[string]interface{}, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	sub, ok := c.subs[entityID]
+	if !ok || !sub.waiting {
+		return
+	}
+	switch {
+	case c.legacy:
+		// Legacy clients only receive updates.
+	case err != nil:
+		c.sendLocked(&types.WsResponse{Type: types.WsError, EntityID: entityID, Error: "get snapshot: " + err.Error()})
+	default:
+		if properties == nil {
+			properties = make(map[string]interface{})
+		}
+		c.sendProperties(types.WsSnapshot, entityID, sub.fields, properties)
+	}
+	for _, pending := range sub.pending {
+		c.sendProperties(types.WsData, entityID, sub.fields, pending)
+	}
+	sub.pending = nil
+	sub.waiting = false
+}
+
+func (c *wsClient) reply(resp *types.WsResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.legacy {
+		return
+	}
+	c.sendLocked(resp)
+}
+
+// sendProperties sends the properties selected by the fields, an update
+// selecting none is skipped.
+func (c *wsClient) sendProperties(typ, entityID string, fields []string, properties map[string]interface{}) {
+	selected := selectProperties("", properties, fields)
+	if len(selected) == 0 && typ == types.WsData {
+		return
+	}
+	content, err := json.Marshal(selected)
+	if err != nil {
+		log.Error("marshal properties err:", err)
+		return
+	}
+	if c.legacy {
+		c.write(content)
+		return
+	}
+	c.sendLocked(&types.WsResponse{Type: typ, EntityID: entityID, Properties: content})
+}
+
+func (c *wsClient) sendLocked(resp *types.WsResponse) {
+	c.seq++
+	resp.Seq = c.seq
+	data, err := json.Marshal(resp)
+	if err != nil {
+		log.Error("marshal ws message err:", err)
+		return
+	}
+	c.write(data)
+}
+
+// selectProperties returns the properties at or below one of the dot
+// separated paths, keeping their nesting, all of them if there is no path.
+func selectProperties(prefix string, properties map[string]interface{}, fields []string) map[string]interface{} {
+	if len(fields) == 0 {
+		return properties
+	}
+	out := make(map[string]interface{})
+	for k, v := range properties {
+		path := k
+		if prefix != "" {
+			path = prefix + "." + k
+		}
+		if fieldSelected(path, fields) {
+			out[k] = v
+			continue
+		}
+		if nested, ok := v.(map[string]interface{}); ok {
+			if sub := selectProperties(path, nested, fields); len(sub) > 0 {
+				out[k] = sub
+			}
+		}
+	}
+	return out
+}
+
+func fieldSelected(path string, fields []string) bool {
+	for _, field := range fields {
+		if path == field || strings.HasPrefix(path, field+".") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core-broker/pkg/core"
+	"github.com/tkeel-io/core-broker/pkg/metrics"
+	"github.com/tkeel-io/core-broker/pkg/model"
+	"github.com/tkeel-io/kit/log"
+)
+
+// WsRegistry records the core subscriptions of the WebSocket clients of an
+// instance in the database, where the instance holds a lease on them. The
+// instances remove the core subscriptions of the ones whose lease expired.
+type WsRegistry struct {
+	instance   string
+	coreClient core.Client
+	lease      time.Duration
+	// resubscribe subscribes the entities of the clients again after the
+	// instance lost its lease.
+	resubscribe func()
+	stop        chan struct{}
+	done        chan struct{}
+}
+
+func NewWsRegistry(instance string, coreClient core.Client, lease time.Duration) *WsRegistry {
+	return &WsRegistry{
+		instance:   instance,
+		coreClient: coreClient,
+		lease:      lease,
+		stop:       make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+}
+
+// Start takes the lease of the instance and removes the core subscriptions
+// left by its previous run under it, it is called before serving clients.
+func (r *WsRegistry) Start() error {
+	now := time.Now()
+	_, err := model.RenewWsLease(r.instance, now, now.Add(r.lease))
+	if errors.Is(err, model.ErrWsLeaseHeld) {
+		// The lease of the previous run is being collected, the collecting
+		// instance removes its core subscriptions and the lease is taken
+		// once released.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	r.unsubscribeAll(r.instance)
+	return nil
+}
+
+// Run renews the lease and collects the expired instances until Stop is
+// called.
+func (r *WsRegistry) Run() {
+	ticker := time.NewTicker(r.lease / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.stop:
+			close(r.done)
+			return
+		case now := <-ticker.C:
+			r.renew(now)
+			r.collect(now)
+		}
+	}
+}
+
+// Stop stops renewing the lease and releases the instance, the core
+// subscriptions still recorded are left to the other instances.
+func (r *WsRegistry) Stop() {
+	close(r.stop)
+	<-r.done
+	if err := model.ReleaseWsInstance(r.instance); err != nil {
+		log.Error("release ws instance err:", err)
+	}
+}
+
+func (r *WsRegistry) renew(now time.Time) {
+	renewed, err := model.RenewWsLease(r.instance, now, now.Add(r.lease))
+	if errors.Is(err, model.ErrWsLeaseHeld) {
+		// Subscribing the entities again now would race the instance
+		// collecting the core subscriptions, it is done once it released
+		// the lease.
+		log.Warnf("ws instance %s is being collected, waiting for its lease", r.instance)
+		return
+	}
+	if err != nil {
+		log.Error("renew ws lease err:", err)
+		return
+	}
+	if !renewed && r.resubscribe != nil {
+		log.Warnf("ws instance %s lost its lease, subscribing its entities again", r.instance)
+		r.resubscribe()
+	}
+}
+
+// collect removes the core subscriptions of the instances whose lease
+// expired, one instance claims each of them.
+func (r *WsRegistry) collect(now time.Time) {
+	expired, err := model.ExpiredWsInstances(now)
+	if err != nil {
+		log.Error("list expired ws instances err:", err)
+		return
+	}
+	for _, instance := range expired {
+		claimed, err := model.ClaimWsInstance(instance.Name, r.instance, now, now.Add(r.lease))
+		if err != nil {
+			log.Error("claim ws instance err:", err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+		log.Infof("ws instance %s collects the core subscriptions of %s", r.instance, instance.Name)
+		r.unsubscribeAll(instance.Name)
+		if err = model.ReleaseWsInstance(instance.Name); err != nil {
+			log.Error("release ws instance err:", err)
+		}
+	}
+
+	counts, err := model.CountWsSubscriptions()
+	if err != nil {
+		log.Error("count ws subscriptions err:", err)
+		return
+	}
+	metrics.CollectorWsCoreSubscriptions.Reset()
+	for instance, count := range counts {
+		metrics.CollectorWsCoreSubscriptions.WithLabelValues(instance).Set(float64(count))
+	}
+}
+
+// unsubscribeAll removes the core subscriptions of the instance, the ones
+// core fails to remove are kept for the next collection.
+func (r *WsRegistry) unsubscribeAll(instance string) {
+	subscriptions, err := model.WsSubscriptions(instance)
+	if err != nil {
+		log.Error("list ws subscriptions err:", err)
+		return
+	}
+	for _, s := range subscriptions {
+		if err = r.coreClient.Unsubscribe(context.Background(), s.ID, s.UserID); err != nil {
+			log.Errorf("unsubscribe ws subscription %s err: %v", s.ID, err)
+			continue
+		}
+		if err = model.RemoveWsSubscription(s.ID); err != nil {
+			log.Error("remove ws subscription err:", err)
+		}
+	}
+}
+
+// add records the core subscription, a nil registry records nothing.
+func (r *WsRegistry) add(subscriptionID, entityID, userID string) {
+	if r == nil {
+		return
+	}
+	if err := model.AddWsSubscription(&model.WsSubscription{
+		ID:       subscriptionID,
+		Instance: r.instance,
+		EntityID: entityID,
+		UserID:   userID,
+	}); err != nil {
+		log.Error("add ws subscription err:", err)
+	}
+}
+
+func (r *WsRegistry) remove(subscriptionID string) {
+	if r == nil {
+		return
+	}
+	if err := model.RemoveWsSubscription(subscriptionID); err != nil {
+		log.Error("remove ws subscription err:", err)
+	}
+}
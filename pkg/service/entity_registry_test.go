@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/core-broker/pkg/auth"
+	"github.com/tkeel-io/core-broker/pkg/config"
+	"github.com/tkeel-io/core-broker/pkg/model"
+	"github.com/tkeel-io/core-broker/pkg/types"
+)
+
+func TestWsRegistryCollect(t *testing.T) {
+	_, fakeCore := newTestSubscribeService(t)
+	fakeCore.AddEntity("iotd-1", "usr-1", nil)
+	now := time.Now()
+
+	// pod-b died and left its core subscription behind.
+	assert.Nil(t, fakeCore.Subscribe(context.Background(), "iotd-1_pod-b", "iotd-1", "pod-b", "usr-1"))
+	_, err := model.RenewWsLease("pod-b", now.Add(-2*time.Minute), now.Add(-time.Minute))
+	assert.Nil(t, err)
+	assert.Nil(t, model.AddWsSubscription(&model.WsSubscription{ID: "iotd-1_pod-b", Instance: "pod-b", EntityID: "iotd-1", UserID: "usr-1"}))
+
+	registry := NewWsRegistry("pod-a", fakeCore, time.Minute)
+	assert.Nil(t, registry.Start())
+	s := NewEntityService(fakeCore, nil, registry, config.WebSocket{})
+	client := newWsClient(auth.User{ID: "usr-1"}, s.conf)
+	failed, _ := s.subscribe(context.Background(), client, []string{"iotd-1"}, nil)
+	assert.Empty(t, failed)
+	subID := types.SubscriptionIDByJoin("iotd-1", types.Topic)
+	subscriptions, err := model.WsSubscriptions("pod-a")
+	assert.Nil(t, err)
+	assert.Len(t, subscriptions, 1)
+	assert.Equal(t, subID, subscriptions[0].ID)
+
+	registry.collect(now)
+	assert.Len(t, fakeCore.Subscriptions("iotd-1"), 1)
+	assert.Equal(t, subID, fakeCore.Subscriptions("iotd-1")[0].ID)
+	subscriptions, err = model.WsSubscriptions("pod-b")
+	assert.Nil(t, err)
+	assert.Empty(t, subscriptions)
+
+	// pod-a stalled past its lease, pod-c collected it and pod-a subscribes
+	// its entities again once it renews.
+	later := now.Add(2 * time.Minute)
+	NewWsRegistry("pod-c", fakeCore, time.Minute).collect(later)
+	assert.Empty(t, fakeCore.Subscriptions("iotd-1"))
+	registry.renew(later)
+	assert.Len(t, fakeCore.Subscriptions("iotd-1"), 1)
+	subscriptions, err = model.WsSubscriptions("pod-a")
+	assert.Nil(t, err)
+	assert.Len(t, subscriptions, 1)
+
+	// pod-a stalled again. It leaves its entities alone while pod-c is
+	// collecting its core subscriptions and subscribes them again once pod-c
+	// released its lease.
+	later = later.Add(2 * time.Minute)
+	claimed, err := model.ClaimWsInstance("pod-a", "pod-c", later, later.Add(time.Minute))
+	assert.Nil(t, err)
+	assert.True(t, claimed)
+	collector := NewWsRegistry("pod-c", fakeCore, time.Minute)
+	collector.unsubscribeAll("pod-a")
+	registry.renew(later)
+	assert.Empty(t, fakeCore.Subscriptions("iotd-1"))
+	assert.Nil(t, model.ReleaseWsInstance("pod-a"))
+	registry.renew(later)
+	assert.Len(t, fakeCore.Subscriptions("iotd-1"), 1)
+
+	s.unsubscribe(client, []string{"iotd-1"})
+	subscriptions, err = model.WsSubscriptions("pod-a")
+	assert.Nil(t, err)
+	assert.Empty(t, subscriptions)
+}
+
+func TestWsRegistryStart(t *testing.T) {
+	_, fakeCore := newTestSubscribeService(t)
+	fakeCore.AddEntity("iotd-1", "usr-1", nil)
+	now := time.Now()
+
+	// The previous run of pod-a left its core subscription behind and pod-c
+	// is collecting it, the restarted pod-a leaves it to pod-c.
+	assert.Nil(t, fakeCore.Subscribe(context.Background(), "iotd-1_pod-a", "iotd-1", "pod-a", "usr-1"))
+	assert.Nil(t, model.AddWsSubscription(&model.WsSubscription{ID: "iotd-1_pod-a", Instance: "pod-a", EntityID: "iotd-1", UserID: "usr-1"}))
+	_, err := model.RenewWsLease("pod-a", now.Add(-2*time.Minute), now.Add(-time.Minute))
+	assert.Nil(t, err)
+	claimed, err := model.ClaimWsInstance("pod-a", "pod-c", now, now.Add(time.Minute))
+	assert.Nil(t, err)
+	assert.True(t, claimed)
+	registry := NewWsRegistry("pod-a", fakeCore, time.Minute)
+	assert.Nil(t, registry.Start())
+	assert.Len(t, fakeCore.Subscriptions("iotd-1"), 1)
+	subscriptions, err := model.WsSubscriptions("pod-a")
+	assert.Nil(t, err)
+	assert.Len(t, subscriptions, 1)
+
+	// pod-c died before removing them, once its claim expired pod-a takes
+	// its lease and removes them itself.
+	assert.Nil(t, model.DB().Model(&model.WsInstance{}).Where("name = ?", "pod-a").
+		Update("lease_expires_at", now.Add(-time.Minute).UTC()).Error)
+	assert.Nil(t, registry.Start())
+	assert.Empty(t, fakeCore.Subscriptions("iotd-1"))
+	subscriptions, err = model.WsSubscriptions("pod-a")
+	assert.Nil(t, err)
+	assert.Empty(t, subscriptions)
+}
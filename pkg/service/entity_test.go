@@ -0,0 +1,519 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	go_restful "github.com/emicklei/go-restful"
+	"github.com/gorilla/websocket"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	topicpb "github.com/tkeel-io/core-broker/api/topic/v1"
+	"github.com/tkeel-io/core-broker/pkg/auth"
+	"github.com/tkeel-io/core-broker/pkg/config"
+	"github.com/tkeel-io/core-broker/pkg/core/fake"
+	"github.com/tkeel-io/core-broker/pkg/metrics"
+	"github.com/tkeel-io/core-broker/pkg/types"
+)
+
+// testTokens are the access tokens of the test authenticator.
+var testTokens = map[string]auth.User{
+	"token-1": {ID: "usr-1", TenantID: "tenant-1"},
+	"token-2": {ID: "usr-2", TenantID: "tenant-1"},
+}
+
+// newTestEntityService serves the WebSocket endpoint of an entity service
+// on the fake core, whose events are delivered to the service directly.
+func newTestEntityService(t *testing.T) (*EntityService, *fake.Core, string) {
+	fakeCore := fake.New()
+	authenticator := auth.AuthenticatorFunc(func(ctx context.Context, token string) (auth.User, error) {
+		user, ok := testTokens[token]
+		if !ok {
+			return user, auth.ErrInvalidToken
+		}
+		return user, nil
+	})
+	s := NewEntityService(fakeCore, authenticator, nil, config.WebSocket{AllowedOrigins: []string{"https://console.tkeel.io"}})
+	fakeCore.OnEvent(func(ctx context.Context, req *topicpb.TopicEventRequest) (*topicpb.TopicEventResponse, error) {
+		s.deliver(req)
+		return &topicpb.TopicEventResponse{Status: SubscriptionResponseStatusSuccess}, nil
+	})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.GetEntity(go_restful.NewRequest(r), go_restful.NewResponse(w))
+	}))
+	t.Cleanup(server.Close)
+	return s, fakeCore, "ws" + strings.TrimPrefix(server.URL, "http")
+}
+
+// dialWs connects as usr-1 through the gateway.
+func dialWs(t *testing.T, url string) *websocket.Conn {
+	header := http.Header{}
+	header.Set("X-Tkeel-Auth", auth.EncodeAuth(auth.User{ID: "usr-1", Role: "admin", TenantID: "tenant-1"}))
+	conn, _, err := websocket.DefaultDialer.Dial(url, header)
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func request(t *testing.T, conn *websocket.Conn, req types.WsRequest) types.WsResponse {
+	assert.Nil(t, conn.WriteJSON(req))
+	return readWs(t, conn)
+}
+
+func readWs(t *testing.T, conn *websocket.Conn) types.WsResponse {
+	assert.Nil(t, conn.SetReadDeadline(time.Now().Add(time.Second)))
+	resp := types.WsResponse{}
+	assert.Nil(t, conn.ReadJSON(&resp))
+	return resp
+}
+
+func TestWsSubscribeProtocol(t *testing.T) {
+	_, fakeCore, url := newTestEntityService(t)
+	for _, id := range []string{"iotd-1", "iotd-2"} {
+		fakeCore.AddEntity(id, "usr-1", nil)
+	}
+	dashboard := dialWs(t, url)
+
+	resp := request(t, dashboard, types.WsRequest{Type: types.WsSubscribe, RequestID: "1", IDs: []string{"iotd-1", "iotd-2", "iotd-1"}})
+	assert.Equal(t, types.WsResponse{Type: types.WsAck, Seq: 1, RequestID: "1", IDs: []string{"iotd-1", "iotd-2"}}, resp)
+	for _, id := range []string{"iotd-1", "iotd-2"} {
+		resp = readWs(t, dashboard)
+		assert.Equal(t, types.WsSnapshot, resp.Type)
+		assert.Equal(t, id, resp.EntityID)
+	}
+	assert.Len(t, fakeCore.Subscriptions("iotd-1"), 1)
+	assert.Len(t, fakeCore.Subscriptions("iotd-2"), 1)
+	// The snapshots are the properties read when authorizing.
+	assert.Equal(t, 2, fakeCore.Calls(fake.MethodGetDeviceEntity))
+
+	resp = request(t, dashboard, types.WsRequest{Type: "watch", RequestID: "2"})
+	assert.Equal(t, types.WsError, resp.Type)
+	assert.Equal(t, "2", resp.RequestID)
+
+	// A second socket on the same entity shares its core subscription.
+	other := dialWs(t, url)
+	resp = request(t, other, types.WsRequest{Type: types.WsSubscribe, RequestID: "a", IDs: []string{"iotd-1"}})
+	assert.Equal(t, types.WsAck, resp.Type)
+	assert.Equal(t, types.WsSnapshot, readWs(t, other).Type)
+	assert.Equal(t, 2, fakeCore.Calls(fake.MethodSubscribe))
+
+	assert.Nil(t, fakeCore.Emit("iotd-2", map[string]interface{}{"temp": 20.5}))
+	resp = readWs(t, dashboard)
+	assert.Equal(t, types.WsData, resp.Type)
+	assert.Equal(t, uint64(5), resp.Seq)
+	assert.Equal(t, "iotd-2", resp.EntityID)
+	assert.JSONEq(t, `{"temp": 20.5}`, string(resp.Properties))
+
+	resp = request(t, dashboard, types.WsRequest{Type: types.WsUnsubscribe, RequestID: "3", IDs: []string{"iotd-1", "iotd-3"}})
+	assert.Equal(t, types.WsError, resp.Type)
+	assert.Equal(t, "3", resp.RequestID)
+	assert.Equal(t, []string{"iotd-1"}, resp.IDs)
+	assert.Equal(t, []types.WsFailure{{ID: "iotd-3", Error: errWsNotSubscribed.Error()}}, resp.Failed)
+	assert.Len(t, fakeCore.Subscriptions("iotd-1"), 1)
+
+	resp = request(t, dashboard, types.WsRequest{Type: types.WsList, RequestID: "4"})
+	assert.Equal(t, types.WsResponse{Type: types.WsList, Seq: 7, RequestID: "4", IDs: []string{"iotd-2"}}, resp)
+
+	dashboard.Close()
+	assert.Eventually(t, func() bool { return len(fakeCore.Subscriptions("iotd-2")) == 0 }, time.Second, 10*time.Millisecond)
+	assert.Len(t, fakeCore.Subscriptions("iotd-1"), 1)
+}
+
+func TestWsLegacyRequest(t *testing.T) {
+	_, fakeCore, url := newTestEntityService(t)
+	for _, id := range []string{"iotd-1", "iotd-2"} {
+		fakeCore.AddEntity(id, "usr-1", nil)
+	}
+	conn := dialWs(t, url)
+
+	assert.Nil(t, conn.WriteJSON(types.WsRequest{ID: "iotd-1"}))
+	assert.Eventually(t, func() bool { return len(fakeCore.Subscriptions("iotd-1")) == 1 }, time.Second, 10*time.Millisecond)
+	assert.Nil(t, conn.WriteJSON(types.WsRequest{ID: "iotd-2"}))
+	assert.Eventually(t, func() bool { return len(fakeCore.Subscriptions("iotd-2")) == 1 }, time.Second, 10*time.Millisecond)
+	assert.Empty(t, fakeCore.Subscriptions("iotd-1"))
+
+	assert.Nil(t, fakeCore.Emit("iotd-2", map[string]interface{}{"temp": 20.5}))
+	assert.Nil(t, conn.SetReadDeadline(time.Now().Add(time.Second)))
+	_, p, err := conn.ReadMessage()
+	assert.Nil(t, err)
+	properties := make(map[string]interface{})
+	assert.Nil(t, json.Unmarshal(p, &properties))
+	assert.Equal(t, map[string]interface{}{"temp": 20.5}, properties)
+}
+
+func TestWsAuth(t *testing.T) {
+	_, fakeCore, url := newTestEntityService(t)
+	fakeCore.AddEntity("iotd-1", "usr-1", nil)
+	fakeCore.AddEntity("iotd-2", "usr-3", map[string]interface{}{
+		"sysField": map[string]interface{}{"_tenantId": "tenant-1"},
+	})
+	fakeCore.AddEntity("iotd-3", "usr-3", map[string]interface{}{
+		"sysField": map[string]interface{}{"_tenantId": "tenant-2"},
+	})
+
+	_, resp, err := websocket.DefaultDialer.Dial(url, nil)
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	_, resp, err = websocket.DefaultDialer.Dial(url+"?token=unknown", nil)
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	_, resp, err = websocket.DefaultDialer.Dial(url+"?token=token-1", http.Header{"Origin": []string{"https://evil.example"}})
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	conn, _, err := websocket.DefaultDialer.Dial(url+"?token=token-2", http.Header{"Origin": []string{"https://console.tkeel.io"}})
+	assert.Nil(t, err)
+	defer conn.Close()
+	got := request(t, conn, types.WsRequest{Type: types.WsSubscribe, RequestID: "1", IDs: []string{"iotd-1", "iotd-2", "iotd-3"}})
+	assert.Equal(t, types.WsError, got.Type)
+	// iotd-1 has no tenant, only its owner may watch it.
+	assert.Equal(t, []string{"iotd-2"}, got.IDs)
+	assert.Equal(t, []types.WsFailure{
+		{ID: "iotd-1", Error: errWsForbidden.Error()},
+		{ID: "iotd-3", Error: errWsForbidden.Error()},
+	}, got.Failed)
+	subscriptions := fakeCore.Subscriptions("iotd-2")
+	assert.Len(t, subscriptions, 1)
+	assert.Equal(t, "usr-2", subscriptions[0].UserID)
+	assert.Empty(t, fakeCore.Subscriptions("iotd-3"))
+
+	dialer := websocket.Dialer{Subprotocols: []string{wsAuthProtocol, "token-1"}}
+	browser, resp, err := dialer.Dial(url, nil)
+	assert.Nil(t, err)
+	defer browser.Close()
+	assert.Equal(t, wsAuthProtocol, resp.Header.Get("Sec-WebSocket-Protocol"))
+	got = request(t, browser, types.WsRequest{Type: types.WsSubscribe, RequestID: "1", IDs: []string{"iotd-1", "iotd-3"}})
+	assert.Equal(t, []string{"iotd-1"}, got.IDs)
+	assert.Equal(t, []types.WsFailure{{ID: "iotd-3", Error: errWsForbidden.Error()}}, got.Failed)
+}
+
+func TestWsSnapshotFields(t *testing.T) {
+	_, fakeCore, url := newTestEntityService(t)
+	fakeCore.AddEntity("iotd-1", "usr-1", map[string]interface{}{
+		"telemetry":  map[string]interface{}{"temp": 20.5, "hum": 50.0},
+		"attributes": map[string]interface{}{"name": "boiler"},
+	})
+	conn := dialWs(t, url)
+
+	resp := request(t, conn, types.WsRequest{Type: types.WsSubscribe, RequestID: "1", IDs: []string{"iotd-1"}, Fields: []string{"telemetry.temp"}})
+	assert.Equal(t, types.WsAck, resp.Type)
+	resp = readWs(t, conn)
+	assert.Equal(t, types.WsSnapshot, resp.Type)
+	assert.Equal(t, uint64(2), resp.Seq)
+	assert.JSONEq(t, `{"telemetry": {"temp": 20.5}}`, string(resp.Properties))
+
+	// Updates selecting no field are skipped.
+	assert.Nil(t, fakeCore.Emit("iotd-1", map[string]interface{}{"attributes": map[string]interface{}{"name": "pump"}}))
+	assert.Nil(t, fakeCore.Emit("iotd-1", map[string]interface{}{"telemetry": map[string]interface{}{"temp": 21.0, "hum": 48.0}}))
+	resp = readWs(t, conn)
+	assert.Equal(t, types.WsData, resp.Type)
+	assert.Equal(t, uint64(3), resp.Seq)
+	assert.JSONEq(t, `{"telemetry": {"temp": 21}}`, string(resp.Properties))
+
+	// Subscribing again replaces the fields and sends a new snapshot.
+	request(t, conn, types.WsRequest{Type: types.WsSubscribe, RequestID: "2", IDs: []string{"iotd-1"}, Fields: []string{"attributes"}})
+	resp = readWs(t, conn)
+	assert.Equal(t, types.WsSnapshot, resp.Type)
+	assert.JSONEq(t, `{"attributes": {"name": "pump"}}`, string(resp.Properties))
+	assert.Equal(t, 1, fakeCore.Calls(fake.MethodSubscribe))
+	assert.Equal(t, 2, fakeCore.Calls(fake.MethodGetDeviceEntity))
+}
+
+func TestWsClientSnapshotOrder(t *testing.T) {
+	client := newWsClient(auth.User{ID: "usr-1"}, config.Default().WebSocket)
+	client.watch("iotd-1", nil)
+	go func() {
+		// Updates before the snapshot are held back until it is sent.
+		client.update("iotd-1", map[string]interface{}{"temp": 21.0})
+		client.snapshot("iotd-1", map[string]interface{}{"temp": 20.5}, nil)
+		client.update("iotd-1", map[string]interface{}{"temp": 22.0})
+	}()
+	expected := []string{
+		`{"type":"snapshot","seq":1,"entity_id":"iotd-1","properties":{"temp":20.5}}`,
+		`{"type":"data","seq":2,"entity_id":"iotd-1","properties":{"temp":21}}`,
+		`{"type":"data","seq":3,"entity_id":"iotd-1","properties":{"temp":22}}`,
+	}
+	for _, e := range expected {
+		select {
+		case msg := <-client.send:
+			assert.JSONEq(t, e, string(msg.data))
+		case <-time.After(time.Second):
+			t.Fatal("no message")
+		}
+	}
+}
+
+func TestSelectProperties(t *testing.T) {
+	properties := map[string]interface{}{
+		"telemetry":  map[string]interface{}{"temp": 20.5, "hum": 50.0},
+		"attributes": map[string]interface{}{"name": "boiler"},
+		"raw":        "AQI=",
+	}
+	assert.Equal(t, properties, selectProperties("", properties, nil))
+	assert.Equal(t, map[string]interface{}{
+		"telemetry": map[string]interface{}{"temp": 20.5},
+		"raw":       "AQI=",
+	}, selectProperties("", properties, []string{"telemetry.temp", "raw"}))
+	assert.Equal(t, map[string]interface{}{
+		"attributes": map[string]interface{}{"name": "boiler"},
+	}, selectProperties("", properties, []string{"attributes", "telemetry.unknown", "raw.bytes"}))
+}
+
+func TestWsClientOverflow(t *testing.T) {
+	queued := func(c *wsClient) []string {
+		out := make([]string, 0)
+		for len(c.send) > 0 {
+			out = append(out, string((<-c.send).data))
+		}
+		return out
+	}
+	for policy, expected := range map[string][]string{
+		config.OverflowDropOldest: {"2", "3"},
+		config.OverflowDropNewest: {"1", "2"},
+		config.OverflowDisconnect: {"1", "2"},
+	} {
+		client := newWsClient(auth.User{ID: "usr-1"}, config.WebSocket{QueueSize: 2, OverflowPolicy: policy})
+		assert.True(t, client.write([]byte("1")))
+		assert.True(t, client.write([]byte("2")))
+		assert.Equal(t, policy == config.OverflowDropOldest, client.write([]byte("3")), policy)
+		assert.Equal(t, expected, queued(client), policy)
+		select {
+		case <-client.overflow:
+			assert.Equal(t, config.OverflowDisconnect, policy)
+		default:
+			assert.NotEqual(t, config.OverflowDisconnect, policy)
+		}
+	}
+}
+
+func TestWsClientStop(t *testing.T) {
+	client := newWsClient(auth.User{ID: "usr-1"}, config.WebSocket{QueueSize: 1, OverflowPolicy: config.OverflowDropNewest})
+	dropped := metrics.CollectorWsDropped.WithLabelValues(types.Topic)
+	before := testutil.ToFloat64(dropped)
+	assert.True(t, client.write([]byte("1")))
+	assert.False(t, client.write([]byte("2")))
+	assert.Equal(t, before+1, testutil.ToFloat64(dropped))
+	client.stop()
+
+	// The writes after the writer has exited are neither queued nor counted.
+	assert.False(t, client.write([]byte("3")))
+	assert.Len(t, client.send, 1)
+	assert.Equal(t, before+1, testutil.ToFloat64(dropped))
+}
+
+// TestWsFanOutIsolation shows that a client which stops reading neither
+// slows down the delivery of the events nor the other clients.
+func TestWsFanOutIsolation(t *testing.T) {
+	if testing.Short() {
+		t.Skip("load test")
+	}
+	const (
+		fastClients = 20
+		queueSize   = 512
+		events      = 4 * queueSize
+	)
+	s, fakeCore, url := newTestEntityService(t)
+	s.conf.QueueSize = queueSize
+	fakeCore.AddEntity("iotd-1", "usr-1", nil)
+
+	// The slow client is subscribed but its queue is never written out.
+	slow := newWsClient(auth.User{ID: "usr-1"}, s.conf)
+	dropped := testutil.ToFloat64(metrics.CollectorWsDropped.WithLabelValues(types.Topic))
+	failed, _ := s.subscribe(context.Background(), slow, []string{"iotd-1"}, nil)
+	assert.Empty(t, failed)
+	slow.snapshot("iotd-1", nil, nil)
+
+	received := make([]int64, fastClients)
+	for i := 0; i < fastClients; i++ {
+		conn := dialWs(t, url)
+		request(t, conn, types.WsRequest{Type: types.WsSubscribe, RequestID: "1", IDs: []string{"iotd-1"}})
+		assert.Equal(t, types.WsSnapshot, readWs(t, conn).Type)
+		assert.Nil(t, conn.SetReadDeadline(time.Time{}))
+		go func(n *int64) {
+			for {
+				resp := types.WsResponse{}
+				if err := conn.ReadJSON(&resp); err != nil {
+					return
+				}
+				atomic.AddInt64(n, 1)
+			}
+		}(&received[i])
+	}
+	caughtUp := func(emitted int64) func() bool {
+		return func() bool {
+			for i := range received {
+				if atomic.LoadInt64(&received[i]) < emitted {
+					return false
+				}
+			}
+			return true
+		}
+	}
+
+	// The events are emitted in batches the fast clients keep up with, while
+	// the queue of the slow client overflows.
+	const batch = queueSize / 4
+	for i := 0; i < events; i += batch {
+		start := time.Now()
+		for j := i; j < i+batch; j++ {
+			assert.Nil(t, fakeCore.Emit("iotd-1", map[string]interface{}{"seq": float64(j)}))
+		}
+		assert.Less(t, time.Since(start), time.Second)
+		assert.Eventually(t, caughtUp(int64(i+batch)), 5*time.Second, time.Millisecond)
+	}
+	assert.Len(t, slow.send, queueSize)
+	// The snapshot was queued before the events, the fast clients dropped
+	// none.
+	assert.Equal(t, dropped+float64(events+1-queueSize), testutil.ToFloat64(metrics.CollectorWsDropped.WithLabelValues(types.Topic)))
+}
+
+func TestWsKeepalive(t *testing.T) {
+	s, _, url := newTestEntityService(t)
+	s.conf.PingInterval = 20 * time.Millisecond
+	s.conf.IdleTimeout = 100 * time.Millisecond
+
+	// Reading answers the pings, which keeps the connection open.
+	active := dialWs(t, url)
+	idle := dialWs(t, url)
+	resp := request(t, active, types.WsRequest{Type: types.WsList, RequestID: "1"})
+	assert.Equal(t, types.WsList, resp.Type)
+	replies := make(chan types.WsResponse, 1)
+	go func() {
+		for {
+			resp := types.WsResponse{}
+			if err := active.ReadJSON(&resp); err != nil {
+				close(replies)
+				return
+			}
+			replies <- resp
+		}
+	}()
+	time.Sleep(300 * time.Millisecond)
+	assert.Nil(t, active.WriteJSON(types.WsRequest{Type: types.WsList, RequestID: "2"}))
+	select {
+	case resp = <-replies:
+		assert.Equal(t, "2", resp.RequestID)
+	case <-time.After(time.Second):
+		t.Error("no reply")
+	}
+
+	// The idle client does not answer the pings either.
+	idle.SetPingHandler(func(string) error { return nil })
+	assert.Nil(t, idle.SetReadDeadline(time.Now().Add(time.Second)))
+	_, _, err := idle.ReadMessage()
+	assert.True(t, websocket.IsCloseError(err, websocket.CloseNormalClosure), err)
+	assert.Contains(t, err.Error(), "idle timeout")
+
+	// A failed upgrade only ends its own request.
+	resp2, err := http.Get("http" + strings.TrimPrefix(url, "ws") + "?token=token-1")
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp2.StatusCode)
+	resp2.Body.Close()
+}
+
+func TestWsShutdown(t *testing.T) {
+	s, fakeCore, url := newTestEntityService(t)
+	fakeCore.AddEntity("iotd-1", "usr-1", nil)
+	conn := dialWs(t, url)
+	request(t, conn, types.WsRequest{Type: types.WsSubscribe, RequestID: "1", IDs: []string{"iotd-1"}})
+	for i := 0; i < 3; i++ {
+		assert.Nil(t, fakeCore.Emit("iotd-1", map[string]interface{}{"temp": float64(i)}))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	assert.Nil(t, s.Shutdown(ctx))
+	assert.Empty(t, fakeCore.Subscriptions("iotd-1"))
+
+	// The queued messages are written before the close frame.
+	assert.Equal(t, types.WsSnapshot, readWs(t, conn).Type)
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, types.WsData, readWs(t, conn).Type)
+	}
+	_, _, err := conn.ReadMessage()
+	assert.True(t, websocket.IsCloseError(err, websocket.CloseGoingAway), err)
+
+	_, resp, err := websocket.DefaultDialer.Dial(url+"?token=token-1", nil)
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+}
+
+func TestWsUnsubscribeGrace(t *testing.T) {
+	s, fakeCore, url := newTestEntityService(t)
+	s.conf.UnsubscribeGrace = 200 * time.Millisecond
+	fakeCore.AddEntity("iotd-1", "usr-1", nil)
+
+	// A refreshed browser comes back within the grace period.
+	before := dialWs(t, url)
+	request(t, before, types.WsRequest{Type: types.WsSubscribe, RequestID: "1", IDs: []string{"iotd-1"}})
+	before.Close()
+	after := dialWs(t, url)
+	time.Sleep(50 * time.Millisecond)
+	request(t, after, types.WsRequest{Type: types.WsSubscribe, RequestID: "1", IDs: []string{"iotd-1"}})
+	time.Sleep(300 * time.Millisecond)
+	assert.Len(t, fakeCore.Subscriptions("iotd-1"), 1)
+	assert.Equal(t, 1, fakeCore.Calls(fake.MethodSubscribe))
+	assert.Equal(t, 0, fakeCore.Calls(fake.MethodUnsubscribe))
+
+	// The last client left for good.
+	readWs(t, after)
+	resp := request(t, after, types.WsRequest{Type: types.WsUnsubscribe, RequestID: "2", IDs: []string{"iotd-1"}})
+	assert.Equal(t, types.WsAck, resp.Type)
+	assert.Len(t, fakeCore.Subscriptions("iotd-1"), 1)
+	assert.Eventually(t, func() bool { return len(fakeCore.Subscriptions("iotd-1")) == 0 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, 1, fakeCore.Calls(fake.MethodUnsubscribe))
+
+	// Shutting down does not wait for the grace period.
+	request(t, after, types.WsRequest{Type: types.WsSubscribe, RequestID: "3", IDs: []string{"iotd-1"}})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	assert.Nil(t, s.Shutdown(ctx))
+	assert.Empty(t, fakeCore.Subscriptions("iotd-1"))
+}
+
+func TestWsConcurrentSubscribe(t *testing.T) {
+	s, fakeCore, _ := newTestEntityService(t)
+	fakeCore.AddEntity("iotd-1", "usr-1", nil)
+	fakeCore.SetDelay(fake.MethodSubscribe, time.Millisecond)
+	fakeCore.SetDelay(fake.MethodUnsubscribe, time.Millisecond)
+
+	clients := make([]*wsClient, 20)
+	var wg sync.WaitGroup
+	for i := range clients {
+		clients[i] = newWsClient(auth.User{ID: "usr-1"}, s.conf)
+		wg.Add(1)
+		go func(client *wsClient) {
+			defer wg.Done()
+			for j := 0; j < 5; j++ {
+				failed, _ := s.subscribe(context.Background(), client, []string{"iotd-1"}, nil)
+				assert.Empty(t, failed)
+				assert.Empty(t, s.unsubscribe(client, []string{"iotd-1"}))
+			}
+			failed, _ := s.subscribe(context.Background(), client, []string{"iotd-1"}, nil)
+			assert.Empty(t, failed)
+		}(clients[i])
+	}
+	wg.Wait()
+	assert.Len(t, fakeCore.Subscriptions("iotd-1"), 1)
+	assert.Len(t, s.entities["iotd-1"].clients, len(clients))
+
+	for _, client := range clients {
+		wg.Add(1)
+		go func(client *wsClient) {
+			defer wg.Done()
+			s.unsubscribe(client, client.entityIDs())
+		}(client)
+	}
+	wg.Wait()
+	assert.Empty(t, fakeCore.Subscriptions("iotd-1"))
+	assert.Empty(t, s.entities)
+}
@@ -0,0 +1,305 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	pb "github.com/tkeel-io/core-broker/api/subscribe/v1"
+	"github.com/tkeel-io/core-broker/pkg/auth"
+	"github.com/tkeel-io/core-broker/pkg/deviceutil"
+	"github.com/tkeel-io/core-broker/pkg/model"
+	"github.com/tkeel-io/core-broker/pkg/pagination"
+	"github.com/tkeel-io/kit/log"
+)
+
+const (
+	_queryEvaluationInterval = 5 * time.Minute
+	_querySearchPageSize     = 1000
+	_searchSuccessCode       = "io.tkeel.SUCCESS"
+)
+
+// SetSubscribeQuery saves the conditions whose matches are the entities of the
+// subscribe and synchronizes the entities right away, the QueryEvaluator keeps
+// them synchronized afterwards.
+func (s *SubscribeService) SetSubscribeQuery(ctx context.Context, req *pb.SetSubscribeQueryRequest) (*pb.SetSubscribeQueryResponse, error) {
+	authUser, err := auth.GetUser(ctx)
+	if nil != err {
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+	subscribe, err := s.repo.GetSubscribe(ctx, uint(req.Id), authUser.ID)
+	if err != nil {
+		err = errors.Wrap(err, "subscribe and user ID mismatch")
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+	if subscribe.IsDefault {
+		return nil, pb.ErrDefaultSubscribeUnableToModify()
+	}
+
+	resp := &pb.SetSubscribeQueryResponse{Id: req.Id, Status: SuccessStatus}
+	if len(req.Conditions) == 0 {
+		if err = s.repo.ClearSubscribeQuery(ctx, subscribe); err != nil {
+			log.Error("clear subscribe query err:", err)
+			return nil, pb.ErrInternalError()
+		}
+		// The entities of the query stay, managed by hand from now on.
+		if err = s.repo.ReleaseQueryEntities(ctx, subscribe.ID); err != nil {
+			log.Error("release subscribe query entities err:", err)
+			return nil, pb.ErrInternalError()
+		}
+		return resp, nil
+	}
+
+	conditions, err := queryConditions(req.Conditions)
+	if err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInvalidArgumentSomeFields()
+	}
+	query, err := json.Marshal(conditions)
+	if err != nil {
+		log.Error("encode subscribe query err:", err)
+		return nil, pb.ErrInternalError()
+	}
+	now := time.Now()
+	subscribe.Query = string(query)
+	subscribe.QueryEvaluatedAt = &now
+	if err = s.repo.SaveSubscribe(ctx, subscribe); err != nil {
+		log.Error("save subscribe query err:", err)
+		return nil, pb.ErrInternalError()
+	}
+
+	matched, err := s.syncQueryEntities(ctx, subscribe)
+	if err != nil {
+		log.Error("sync subscribe query entities err:", err)
+		return nil, pb.ErrInternalQuery()
+	}
+	resp.Matched = uint64(matched)
+	return resp, nil
+}
+
+// PreviewSubscribeQuery lists the entities the conditions currently match.
+func (s *SubscribeService) PreviewSubscribeQuery(ctx context.Context, req *pb.PreviewSubscribeQueryRequest) (*pb.PreviewSubscribeQueryResponse, error) {
+	authUser, err := auth.GetUser(ctx)
+	if nil != err {
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+	conditions, err := queryConditions(req.Conditions)
+	if err != nil || len(conditions) == 0 {
+		log.Error("invalid query conditions:", err)
+		return nil, pb.ErrInvalidArgumentSomeFields()
+	}
+	page, err := pagination.Parse(req)
+	if err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInvalidArgument()
+	}
+	if page.OrderBy, err = entitySortField(page.OrderBy); err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInvalidArgument()
+	}
+
+	scope := deviceutil.CacheScope(authUser.TenantID, authUser.ID)
+	data, err := s.getEntitiesByConditions(ownedDeviceConditions(authUser.ID, conditions), scope, authUser.Token, authUser.Auth, &page)
+	if err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInternalQuery()
+	}
+
+	resp := &pb.PreviewSubscribeQueryResponse{}
+	if err = page.FillResponse(resp); err != nil {
+		log.Error("page fill error:", err)
+		return nil, pb.ErrList()
+	}
+	resp.Data = data
+	return resp, nil
+}
+
+// syncQueryEntities adds the entities matched by the query of the subscribe
+// and removes the ones it added which are no longer matched. It returns the
+// number of matches. The QueryEvaluator has no user around, so the broker
+// searches core itself whoever triggers the sync, restricted to the devices
+// of the owner of the subscribe.
+func (s *SubscribeService) syncQueryEntities(ctx context.Context, subscribe *model.Subscribe) (int, error) {
+	conditions := make(deviceutil.Conditions, 0)
+	if err := json.Unmarshal([]byte(subscribe.Query), &conditions); err != nil {
+		return 0, errors.Wrap(err, "decode subscribe query")
+	}
+	matched, err := searchEntityIDs(deviceutil.NewServiceClient(), s.devices.CoreEntitySearch, ownedDeviceConditions(subscribe.UserID, conditions))
+	if err != nil {
+		return 0, err
+	}
+	current, err := s.repo.EntityIDs(ctx, subscribe.ID)
+	if err != nil {
+		return 0, errors.Wrap(err, "list subscribe entities")
+	}
+	fromQuery, err := s.repo.QueryEntityIDs(ctx, subscribe.ID)
+	if err != nil {
+		return 0, errors.Wrap(err, "list subscribe query entities")
+	}
+
+	added, _ := diffEntityIDs(current, matched)
+	_, removed := diffEntityIDs(fromQuery, matched)
+	if len(added) != 0 {
+		log.Infof("subscribe %d query matched %d new entities", subscribe.ID, len(added))
+		results, err := model.SubscribeQueryEntitiesBulk(ctx, s.bulk, subscribe, added)
+		if err != nil {
+			return 0, errors.Wrap(err, "add subscribe entities")
+		}
+		for _, r := range results {
+			if r.Err != nil {
+				log.Errorf("subscribe %d query failed to add entity %s: %v", subscribe.ID, r.EntityID, r.Err)
+			}
+		}
+	}
+	if len(removed) != 0 {
+		log.Infof("subscribe %d query no longer matches %d entities", subscribe.ID, len(removed))
+		if err = s.repo.DeleteEntities(ctx, *subscribe, removed); err != nil {
+			return 0, errors.Wrap(err, "delete subscribe entities")
+		}
+	}
+	return len(matched), nil
+}
+
+func queryConditions(conditions []*pb.QueryCondition) (deviceutil.Conditions, error) {
+	out := make(deviceutil.Conditions, 0, len(conditions))
+	for _, c := range conditions {
+		if c.Field == "" {
+			return nil, errors.New("query condition field is empty")
+		}
+		if !strings.HasPrefix(c.Operator, "$") {
+			return nil, errors.Errorf("invalid query condition operator %q", c.Operator)
+		}
+		out = append(out, deviceutil.NewQuery(c.Field, c.Operator, c.Value))
+	}
+	return out, nil
+}
+
+func queryConditionsResponse(subscribe *model.Subscribe) []*pb.QueryCondition {
+	if subscribe.Query == "" {
+		return nil
+	}
+	conditions := make(deviceutil.Conditions, 0)
+	if err := json.Unmarshal([]byte(subscribe.Query), &conditions); err != nil {
+		log.Errorf("decode query of subscribe %d err: %v", subscribe.ID, err)
+		return nil
+	}
+	out := make([]*pb.QueryCondition, 0, len(conditions))
+	for _, c := range conditions {
+		out = append(out, &pb.QueryCondition{Field: c.Field, Operator: c.Operator, Value: c.Value})
+	}
+	return out
+}
+
+// ownedDeviceConditions restricts the conditions to the devices of the user.
+func ownedDeviceConditions(userID string, conditions deviceutil.Conditions) deviceutil.Conditions {
+	out := make(deviceutil.Conditions, 0, len(conditions)+2)
+	out = append(out, deviceutil.EqQuery(Owner, userID), deviceutil.DeviceTypeQuery())
+	return append(out, conditions...)
+}
+
+func searchEntityIDs(client *deviceutil.Client, service deviceutil.Service, conditions deviceutil.Conditions) ([]string, error) {
+	ids := make([]string, 0)
+	for page := int32(1); ; page++ {
+		bytes, err := client.Search(service, conditions, deviceutil.WithPagination(page, _querySearchPageSize))
+		if err != nil {
+			return nil, errors.Wrap(err, "search entities")
+		}
+		resp, err := deviceutil.ParseSearchEntityResponse(bytes)
+		if err != nil {
+			return nil, errors.Wrap(err, "parse search entities response")
+		}
+		if resp.Code != _searchSuccessCode {
+			return nil, errors.Errorf("search entities failed: %s %s", resp.Code, resp.Msg)
+		}
+		for _, item := range resp.Data.Items {
+			ids = append(ids, item.Id)
+		}
+		if len(resp.Data.Items) == 0 || len(ids) >= int(resp.Data.Total) {
+			return ids, nil
+		}
+	}
+}
+
+// diffEntityIDs returns the IDs in matched but not in current, and the IDs in
+// current but not in matched.
+func diffEntityIDs(current, matched []string) (added, removed []string) {
+	currentSet := make(map[string]struct{}, len(current))
+	for _, id := range current {
+		currentSet[id] = struct{}{}
+	}
+	matchedSet := make(map[string]struct{}, len(matched))
+	for _, id := range matched {
+		matchedSet[id] = struct{}{}
+		if _, ok := currentSet[id]; !ok {
+			added = append(added, id)
+		}
+	}
+	for _, id := range current {
+		if _, ok := matchedSet[id]; !ok {
+			removed = append(removed, id)
+		}
+	}
+	return added, removed
+}
+
+// QueryEvaluator periodically synchronizes the entities of the subscribes
+// defined by a query.
+type QueryEvaluator struct {
+	subscribes *SubscribeService
+	stop       chan struct{}
+	done       chan struct{}
+}
+
+func NewQueryEvaluator(subscribes *SubscribeService) *QueryEvaluator {
+	return &QueryEvaluator{
+		subscribes: subscribes,
+		stop:       make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+}
+
+// Run evaluates the queries until Stop is called.
+func (e *QueryEvaluator) Run() {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-e.stop:
+			close(e.done)
+			return
+		case now := <-ticker.C:
+			e.evaluate(now)
+		}
+	}
+}
+
+func (e *QueryEvaluator) Stop() {
+	close(e.stop)
+	<-e.done
+}
+
+func (e *QueryEvaluator) evaluate(now time.Time) {
+	subscribes, err := e.subscribes.repo.QuerySubscribes(context.Background())
+	if err != nil {
+		log.Error("list query subscribes err:", err)
+		return
+	}
+	for i := range subscribes {
+		claimed, err := e.subscribes.repo.ClaimQueryEvaluation(context.Background(), &subscribes[i], now, _queryEvaluationInterval)
+		if err != nil {
+			log.Errorf("claim query evaluation of subscribe %d err: %v", subscribes[i].ID, err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+		if _, err = e.subscribes.syncQueryEntities(context.Background(), &subscribes[i]); err != nil {
+			log.Errorf("sync query entities of subscribe %d err: %v", subscribes[i].ID, err)
+		}
+	}
+}
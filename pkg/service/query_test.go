@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	pb "github.com/tkeel-io/core-broker/api/subscribe/v1"
+	"github.com/tkeel-io/core-broker/pkg/deviceutil"
+	"github.com/tkeel-io/core-broker/pkg/model"
+)
+
+func TestDiffEntityIDs(t *testing.T) {
+	added, removed := diffEntityIDs([]string{"a", "b", "c"}, []string{"b", "c", "d", "e"})
+	assert.Equal(t, []string{"d", "e"}, added)
+	assert.Equal(t, []string{"a"}, removed)
+
+	added, removed = diffEntityIDs(nil, nil)
+	assert.Empty(t, added)
+	assert.Empty(t, removed)
+}
+
+func TestQueryConditions(t *testing.T) {
+	conditions, err := queryConditions([]*pb.QueryCondition{
+		{Field: "basicInfo.templateId", Operator: "$eq", Value: "iotd-template"},
+		{Field: "sysField._spacePath", Operator: "$wildcard", Value: "iotd-group"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, deviceutil.Conditions{
+		deviceutil.EqQuery("basicInfo.templateId", "iotd-template"),
+		deviceutil.WildcardQuery("sysField._spacePath", "iotd-group"),
+	}, conditions)
+
+	_, err = queryConditions([]*pb.QueryCondition{{Field: "", Operator: "$eq"}})
+	assert.NotNil(t, err)
+	_, err = queryConditions([]*pb.QueryCondition{{Field: "owner", Operator: "eq"}})
+	assert.NotNil(t, err)
+
+	owned := ownedDeviceConditions("usr-1", conditions)
+	assert.Equal(t, deviceutil.EqQuery(Owner, "usr-1"), owned[0])
+	assert.Equal(t, deviceutil.DeviceTypeQuery(), owned[1])
+	assert.Len(t, owned, 4)
+}
+
+func TestSyncQueryEntitiesKeepsManualEntities(t *testing.T) {
+	s, fakeCore := newTestSubscribeService(t)
+	for _, id := range []string{"iotd-1", "iotd-2", "iotd-3"} {
+		fakeCore.AddEntity(id, "usr-1", nil)
+	}
+	matched := []string{"iotd-1", "iotd-2"}
+	var searched deviceutil.Conditions
+	search := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := deviceutil.SearchRequest{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&req))
+		searched = req.Conditions
+		items := make([]map[string]interface{}, 0, len(matched))
+		for _, id := range matched {
+			items = append(items, map[string]interface{}{"id": id})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"code": _searchSuccessCode,
+			"data": map[string]interface{}{"items": items, "total": len(items)},
+		})
+	}))
+	defer search.Close()
+	s.devices.CoreEntitySearch = deviceutil.Service(search.URL)
+	ctx := userContext("usr-1", "tenant-1")
+	_, err := s.CreateSubscribe(ctx, &pb.CreateSubscribeRequest{Title: "default"})
+	assert.Nil(t, err)
+	created, err := s.CreateSubscribe(ctx, &pb.CreateSubscribeRequest{Title: "dashboard"})
+	assert.Nil(t, err)
+	_, err = s.SubscribeEntitiesByIDs(ctx, &pb.SubscribeEntitiesByIDsRequest{Id: created.Id, Entities: []string{"iotd-3"}})
+	assert.Nil(t, err)
+
+	// Setting the query searches core like the QueryEvaluator, scoped to
+	// the owner of the subscribe.
+	resp, err := s.SetSubscribeQuery(ctx, &pb.SetSubscribeQueryRequest{Id: created.Id, Conditions: []*pb.QueryCondition{
+		{Field: "basicInfo.templateId", Operator: "$eq", Value: "iotd-template"},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), resp.Matched)
+	assert.Equal(t, deviceutil.EqQuery(Owner, "usr-1"), searched[0])
+	subscribe := model.Subscribe{}
+	assert.Nil(t, model.DB().First(&subscribe, created.Id).Error)
+	sync := func() {
+		_, err = s.syncQueryEntities(context.Background(), &subscribe)
+		assert.Nil(t, err)
+	}
+	ids, err := subscribe.EntityIDs()
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"iotd-1", "iotd-2", "iotd-3"}, ids)
+
+	// The query no longer matches iotd-2, it never matched iotd-3.
+	matched = []string{"iotd-1"}
+	sync()
+	ids, err = subscribe.EntityIDs()
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"iotd-1", "iotd-3"}, ids)
+	assert.Empty(t, fakeCore.Subscriptions("iotd-2"))
+
+	// Added by hand, iotd-1 stays once the query no longer matches it.
+	_, err = s.SubscribeEntitiesByIDs(ctx, &pb.SubscribeEntitiesByIDsRequest{Id: created.Id, Entities: []string{"iotd-1"}})
+	assert.Nil(t, err)
+	matched = nil
+	sync()
+	ids, err = subscribe.EntityIDs()
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"iotd-1", "iotd-3"}, ids)
+}
@@ -3,25 +3,37 @@ package service
 import (
 	"context"
 	"strconv"
-	"strings"
+	"time"
 
-	"github.com/go-sql-driver/mysql"
 	"github.com/tkeel-io/core-broker/pkg/auth"
 
 	"github.com/pkg/errors"
 	pb "github.com/tkeel-io/core-broker/api/subscribe/v1"
+	"github.com/tkeel-io/core-broker/pkg/config"
 	"github.com/tkeel-io/core-broker/pkg/deviceutil"
 	"github.com/tkeel-io/core-broker/pkg/model"
 	"github.com/tkeel-io/core-broker/pkg/pagination"
-	"github.com/tkeel-io/core-broker/pkg/subscribeuril"
+	"github.com/tkeel-io/core-broker/pkg/schedule"
 	"github.com/tkeel-io/kit/log"
-	"gorm.io/gorm"
 )
 
 const (
 	SuccessStatus     = "SUCCESS"
 	ErrPartialFailure = "PARTIAL FAILURE"
 
+	// entity results of subscribing and unsubscribing.
+	_EntityNotSubscribed     = "NOT_SUBSCRIBED"
+	_EntityAlreadySubscribed = "ALREADY_SUBSCRIBED"
+	_EntityFailure           = "FAILURE"
+	// the membership has been transferred but core is out of sync.
+	_EntityCoreOutOfSync = "MOVED_CORE_OUT_OF_SYNC"
+
+	// modes of ChangeSubscribed.
+	_ChangeSubscribedMove = "move"
+	_ChangeSubscribedCopy = "copy"
+
+	_deviceSearchBatchSize = 100
+
 	_DefaultSubscribeTitle       = "我的订阅"
 	_DefaultSubscribeDescription = "平台默认订阅，该订阅无法被删除，无法被修改。"
 )
@@ -30,14 +42,23 @@ var ErrDeviceNotFound = errors.New("device not found")
 
 type SubscribeService struct {
 	pb.UnimplementedSubscribeServer
+	repo     model.Repository
+	devices  deviceutil.Services
+	entities *deviceutil.EntityCache
+	bulk     config.BulkSubscribe
 }
 
-func NewSubscribeService() *SubscribeService {
-	if err := model.Setup(); err != nil {
-		log.Fatal(err)
+// NewSubscribeService stores the subscribes in repo, the subscribes reach
+// core through the hooks of the models set up by model.Setup. The devices
+// are looked up with the searches of devices and their metadata is cached
+// in entities, bulk tunes adding many entities at once.
+func NewSubscribeService(repo model.Repository, devices deviceutil.Services, entities *deviceutil.EntityCache, bulk config.BulkSubscribe) *SubscribeService {
+	return &SubscribeService{
+		repo:     repo,
+		devices:  devices,
+		entities: entities,
+		bulk:     bulk,
 	}
-
-	return &SubscribeService{}
 }
 
 func (s *SubscribeService) SubscribeEntitiesByIDs(ctx context.Context, req *pb.SubscribeEntitiesByIDsRequest) (*pb.SubscribeEntitiesByIDsResponse, error) {
@@ -46,10 +67,9 @@ func (s *SubscribeService) SubscribeEntitiesByIDs(ctx context.Context, req *pb.S
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
-	subscribe := model.Subscribe{Model: gorm.Model{ID: uint(req.Id)}, UserID: authUser.ID, TenantID: authUser.TenantID}
-	validateSubscribeResult := model.DB().First(&subscribe)
-	if validateSubscribeResult.RowsAffected == 0 {
-		err = errors.Wrap(validateSubscribeResult.Error, "subscribe and user ID mismatch")
+	subscribe, err := s.repo.GetSubscribe(ctx, uint(req.Id), authUser.ID)
+	if err != nil {
+		err = errors.Wrap(err, "subscribe and user ID mismatch")
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
@@ -62,32 +82,37 @@ func (s *SubscribeService) SubscribeEntitiesByIDs(ctx context.Context, req *pb.S
 		return resp, nil
 	}
 
-	records := s.createSubscribeEntitiesRecords(req.Entities, &subscribe)
-	err = CreateSubscribeEntities(records)
+	resp.Status, resp.Results, err = s.addEntities(ctx, subscribe, req.Entities)
 	if err != nil {
-		return nil, err
+		log.Error("err:", err)
+		return nil, pb.ErrInternalError()
 	}
 	return resp, nil
 }
 
-func CreateSubscribeEntities(records []*model.SubscribeEntities) (err error) {
-	var affected int64
-	for _, record := range records {
-		result := model.DB().Preload("Subscribe").Create(record)
-		if result.Error != nil {
-			log.Error("err:", result.Error)
-			mysqlErr, ok := result.Error.(*mysql.MySQLError)
-			if ok && mysqlErr.Number == 1062 {
-				continue
-			}
-			//			return pb.ErrInternalError()
+// addEntities adds the entities to the subscribe and reports the result of
+// each entity.
+func (s *SubscribeService) addEntities(ctx context.Context, subscribe *model.Subscribe, entityIDs []string) (string, []*pb.EntityResult, error) {
+	bulkResults, err := model.SubscribeEntitiesBulk(ctx, s.bulk, subscribe, entityIDs)
+	if err != nil {
+		return "", nil, err
+	}
+	status := SuccessStatus
+	results := make([]*pb.EntityResult, 0, len(bulkResults))
+	for _, r := range bulkResults {
+		result := &pb.EntityResult{Id: r.EntityID, Status: SuccessStatus}
+		switch {
+		case r.Duplicate:
+			result.Status = _EntityAlreadySubscribed
+		case r.Err != nil:
+			log.Errorf("subscribe entity %s to subscribe %d err: %v", r.EntityID, subscribe.ID, r.Err)
+			result.Status = _EntityFailure
+			result.Message = r.Err.Error()
+			status = ErrPartialFailure
 		}
-		affected += 1
+		results = append(results, result)
 	}
-	if affected != int64(len(records)) {
-		log.Warn(pb.ErrSomeDuplicateCreate())
-	}
-	return nil
+	return status, results, nil
 }
 
 func (s *SubscribeService) SubscribeEntitiesByGroups(ctx context.Context, req *pb.SubscribeEntitiesByGroupsRequest) (*pb.SubscribeEntitiesByGroupsResponse, error) {
@@ -96,10 +121,9 @@ func (s *SubscribeService) SubscribeEntitiesByGroups(ctx context.Context, req *p
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
-	subscribe := model.Subscribe{Model: gorm.Model{ID: uint(req.Id)}, UserID: authUser.ID, TenantID: authUser.TenantID}
-	validateSubscribeResult := model.DB().First(&subscribe)
-	if validateSubscribeResult.RowsAffected == 0 {
-		err = errors.Wrap(validateSubscribeResult.Error, "subscribe and user ID mismatch")
+	subscribe, err := s.repo.GetSubscribe(ctx, uint(req.Id), authUser.ID)
+	if err != nil {
+		err = errors.Wrap(err, "subscribe and user ID mismatch")
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
@@ -108,7 +132,7 @@ func (s *SubscribeService) SubscribeEntitiesByGroups(ctx context.Context, req *p
 		Id:     req.Id,
 		Status: SuccessStatus,
 	}
-	ids, err := s.getDeviceEntitiesIDsFromGroups(ctx, req.Groups, authUser.Token, authUser.Token)
+	ids, err := s.getDeviceEntitiesIDsFromGroups(ctx, req.Groups, true, authUser.Token, authUser.Auth)
 	if err != nil {
 		err = errors.Wrap(err, "get device entities IDs from groups IDs error")
 		log.Error("err:", err)
@@ -119,12 +143,10 @@ func (s *SubscribeService) SubscribeEntitiesByGroups(ctx context.Context, req *p
 		log.Debug("no device entities IDs found")
 		return nil, pb.ErrDeviceNotFound()
 	}
-	records := s.createSubscribeEntitiesRecords(ids, &subscribe)
-	log.Info("create subscribe entities records:", records)
-
-	err = CreateSubscribeEntities(records)
+	resp.Status, resp.Results, err = s.addEntities(ctx, subscribe, ids)
 	if err != nil {
-		return nil, err
+		log.Error("err:", err)
+		return nil, pb.ErrInternalError()
 	}
 	return resp, nil
 }
@@ -135,10 +157,9 @@ func (s *SubscribeService) SubscribeEntitiesByModels(ctx context.Context, req *p
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
-	subscribe := model.Subscribe{Model: gorm.Model{ID: uint(req.Id)}, UserID: authUser.ID, TenantID: authUser.TenantID}
-	validateSubscribeResult := model.DB().First(&subscribe)
-	if validateSubscribeResult.RowsAffected == 0 {
-		err = errors.Wrap(validateSubscribeResult.Error, "subscribe and user ID mismatch")
+	subscribe, err := s.repo.GetSubscribe(ctx, uint(req.Id), authUser.ID)
+	if err != nil {
+		err = errors.Wrap(err, "subscribe and user ID mismatch")
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
@@ -158,11 +179,10 @@ func (s *SubscribeService) SubscribeEntitiesByModels(ctx context.Context, req *p
 		log.Debug("no device entities IDs found")
 		return nil, pb.ErrDeviceNotFound()
 	}
-	records := s.createSubscribeEntitiesRecords(ids, &subscribe)
-
-	err = CreateSubscribeEntities(records)
+	resp.Status, resp.Results, err = s.addEntities(ctx, subscribe, ids)
 	if err != nil {
-		return nil, err
+		log.Error("err:", err)
+		return nil, pb.ErrInternalError()
 	}
 	return resp, nil
 }
@@ -175,16 +195,14 @@ func (s *SubscribeService) DeleteEntitiesByID(ctx context.Context, req *pb.Delet
 	}
 
 	resp := &pb.DeleteEntitiesByIDResponse{Id: req.Id}
-	subEntities := make([]*model.SubscribeEntities, 0)
-	res := model.DB().Model(&model.SubscribeEntities{}).
-		Where(&model.SubscribeEntities{EntityID: req.Id}).Find(&subEntities)
-	log.Info(res)
-	if res.Error != nil {
+	subscribeIDs, err := s.repo.EntitySubscribeIDs(ctx, req.Id)
+	if err != nil {
+		log.Error("list entity subscribes err:", err)
 		return resp, nil
 	}
-	for _, e := range subEntities {
+	for _, subscribeID := range subscribeIDs {
 		s.UnsubscribeEntitiesByIDs(ctx, &pb.UnsubscribeEntitiesByIDsRequest{
-			Id:       uint64(e.SubscribeID),
+			Id:       uint64(subscribeID),
 			Entities: []string{req.Id},
 		})
 	}
@@ -197,9 +215,9 @@ func (s *SubscribeService) UnsubscribeEntitiesByIDs(ctx context.Context, req *pb
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
-	subscribe := model.Subscribe{Model: gorm.Model{ID: uint(req.Id)}, UserID: authUser.ID}
-	if model.DB().First(&subscribe).RowsAffected == 0 {
-		err = errors.New("subscribe and user ID mismatch")
+	subscribe, err := s.repo.GetSubscribe(ctx, uint(req.Id), authUser.ID)
+	if err != nil {
+		err = errors.Wrap(err, "subscribe and user ID mismatch")
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
@@ -209,40 +227,125 @@ func (s *SubscribeService) UnsubscribeEntitiesByIDs(ctx context.Context, req *pb
 		Status: SuccessStatus,
 	}
 
-	tx := model.DB().Begin()
-	for _, entityID := range req.Entities {
-		subscribeEntity := model.SubscribeEntities{
-			Subscribe:   subscribe,
-			EntityID:    entityID,
-			SubscribeID: subscribe.ID,
-			UniqueKey:   subscribeuril.GenerateSubscribeTopic(subscribe.ID, entityID),
-		}
-		result := tx.
-			Where("subscribe_id = ?", subscribeEntity.SubscribeID).
-			Where("entity_id = ?", subscribeEntity.EntityID).
-			Where("unique_key = ?", subscribeEntity.UniqueKey).
-			Delete(&subscribeEntity)
-		if result.Error != nil {
-			log.Error("err:", result.Error)
-			tx.Rollback()
-			return nil, pb.ErrInternalError()
-		}
+	if err = s.repo.DeleteEntities(ctx, *subscribe, req.Entities); err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInternalError()
 	}
-	tx.Commit()
 
 	return resp, nil
 }
 
+func (s *SubscribeService) UnsubscribeEntitiesByGroups(ctx context.Context, req *pb.UnsubscribeEntitiesByGroupsRequest) (*pb.UnsubscribeEntitiesByGroupsResponse, error) {
+	authUser, err := auth.GetUser(ctx)
+	if nil != err {
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+	subscribe, err := s.repo.GetSubscribe(ctx, uint(req.Id), authUser.ID)
+	if err != nil {
+		err = errors.Wrap(err, "subscribe and user ID mismatch")
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+
+	ids, err := s.getDeviceEntitiesIDsFromGroups(ctx, req.Groups, req.IncludeSubgroups, authUser.Token, authUser.Auth)
+	if err != nil {
+		err = errors.Wrap(err, "get device entities IDs from groups IDs error")
+		log.Error("err:", err)
+		return nil, pb.ErrInternalQuery()
+	}
+	if len(ids) == 0 {
+		log.Debug("no device entities IDs found")
+		return nil, pb.ErrDeviceNotFound()
+	}
+
+	resp := &pb.UnsubscribeEntitiesByGroupsResponse{Id: req.Id}
+	resp.Status, resp.Results, err = s.unsubscribeEntities(ctx, *subscribe, ids)
+	if err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInternalError()
+	}
+	return resp, nil
+}
+
+func (s *SubscribeService) UnsubscribeEntitiesByModels(ctx context.Context, req *pb.UnsubscribeEntitiesByModelsRequest) (*pb.UnsubscribeEntitiesByModelsResponse, error) {
+	authUser, err := auth.GetUser(ctx)
+	if nil != err {
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+	subscribe, err := s.repo.GetSubscribe(ctx, uint(req.Id), authUser.ID)
+	if err != nil {
+		err = errors.Wrap(err, "subscribe and user ID mismatch")
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+
+	ids, err := s.getDeviceEntitiesIDsFromTemplates(ctx, req.Models, authUser.Token, authUser.Auth)
+	if err != nil {
+		err = errors.Wrap(err, "get device entities IDs from models IDs error")
+		log.Error("err:", err)
+		return nil, pb.ErrInternalQuery()
+	}
+	if len(ids) == 0 {
+		log.Debug("no device entities IDs found")
+		return nil, pb.ErrDeviceNotFound()
+	}
+
+	resp := &pb.UnsubscribeEntitiesByModelsResponse{Id: req.Id}
+	resp.Status, resp.Results, err = s.unsubscribeEntities(ctx, *subscribe, ids)
+	if err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInternalError()
+	}
+	return resp, nil
+}
+
+// unsubscribeEntities removes the entities from the subscribe one by one and
+// reports the result of each entity.
+func (s *SubscribeService) unsubscribeEntities(ctx context.Context, subscribe model.Subscribe, entityIDs []string) (string, []*pb.EntityResult, error) {
+	subscribed, err := s.repo.EntityIDs(ctx, subscribe.ID)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "list subscribe entities")
+	}
+	members := make(map[string]struct{}, len(subscribed))
+	for _, id := range subscribed {
+		members[id] = struct{}{}
+	}
+
+	status := SuccessStatus
+	seen := make(map[string]struct{}, len(entityIDs))
+	results := make([]*pb.EntityResult, 0, len(entityIDs))
+	for _, entityID := range entityIDs {
+		if _, ok := seen[entityID]; ok {
+			continue
+		}
+		seen[entityID] = struct{}{}
+		result := &pb.EntityResult{Id: entityID, Status: SuccessStatus}
+		results = append(results, result)
+		if _, ok := members[entityID]; !ok {
+			result.Status = _EntityNotSubscribed
+			continue
+		}
+		if err = s.repo.DeleteEntities(ctx, subscribe, []string{entityID}); err != nil {
+			log.Errorf("unsubscribe entity %s from subscribe %d err: %v", entityID, subscribe.ID, err)
+			result.Status = _EntityFailure
+			result.Message = err.Error()
+			status = ErrPartialFailure
+		}
+	}
+	return status, results, nil
+}
+
 func (s *SubscribeService) ListSubscribeEntities(ctx context.Context, req *pb.ListSubscribeEntitiesRequest) (*pb.ListSubscribeEntitiesResponse, error) {
 	authUser, err := auth.GetUser(ctx)
 	if nil != err {
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
-	subscribe := model.Subscribe{Model: gorm.Model{ID: uint(req.Id)}, UserID: authUser.ID}
-	validateSubscribeResult := model.DB().First(&subscribe)
-	if validateSubscribeResult.RowsAffected == 0 {
-		err = errors.Wrap(validateSubscribeResult.Error, "subscribe and user ID mismatch")
+	subscribe, err := s.repo.GetSubscribe(ctx, uint(req.Id), authUser.ID)
+	if err != nil {
+		err = errors.Wrap(err, "subscribe and user ID mismatch")
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
@@ -256,7 +359,24 @@ func (s *SubscribeService) ListSubscribeEntities(ctx context.Context, req *pb.Li
 	conditions := make(deviceutil.Conditions, 0)
 	conditions = append(conditions, deviceutil.EqQuery(Owner, authUser.ID))
 	conditions = append(conditions, deviceutil.WildcardQuery(SubscribePath, subscribe.Endpoint))
-	data, err := s.getEntitiesByConditions(conditions, authUser.Token, authUser.Auth, &page)
+	filters, err := entityFilters(req)
+	if err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInvalidArgumentSomeFields()
+	}
+	conditions = append(conditions, filters...)
+	if page.OrderBy, err = entitySortField(page.OrderBy); err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInvalidArgument()
+	}
+	scope := deviceutil.CacheScope(authUser.TenantID, authUser.ID)
+	var data []*pb.Entity
+	if len(filters) == 0 && page.KeyWords == "" && page.OrderBy == "" {
+		// Nothing to search for, the page of memberships is looked up.
+		data, err = s.subscribeEntities(ctx, subscribe, scope, authUser.Token, authUser.Auth, &page)
+	} else {
+		data, err = s.getEntitiesByConditions(conditions, scope, authUser.Token, authUser.Auth, &page)
+	}
 	if err != nil {
 		log.Error("err:", err)
 		if errors.Is(err, ErrDeviceNotFound) {
@@ -276,53 +396,106 @@ func (s *SubscribeService) ListSubscribeEntities(ctx context.Context, req *pb.Li
 	return resp, nil
 }
 
+// entityFilters builds the search conditions of the filters of the request.
+func entityFilters(req *pb.ListSubscribeEntitiesRequest) (deviceutil.Conditions, error) {
+	conditions := make(deviceutil.Conditions, 0)
+	switch req.Status {
+	case "":
+	case "online":
+		conditions = append(conditions, deviceutil.EqQuery(OnlineStatus, "true"))
+	case "offline":
+		conditions = append(conditions, deviceutil.EqQuery(OnlineStatus, "false"))
+	default:
+		return nil, errors.Errorf("invalid status %q", req.Status)
+	}
+	if req.TemplateId != "" {
+		conditions = append(conditions, deviceutil.EqQuery(TemplateID, req.TemplateId))
+	}
+	if req.GroupId != "" {
+		if req.IncludeSubgroups {
+			conditions = append(conditions, deviceutil.GroupQuery(req.GroupId))
+		} else {
+			conditions = append(conditions, deviceutil.EqQuery(ParentID, req.GroupId))
+		}
+	}
+	if req.UpdatedAfter != 0 && req.UpdatedBefore != 0 && req.UpdatedAfter > req.UpdatedBefore {
+		return nil, errors.New("updated_after is after updated_before")
+	}
+	if req.UpdatedAfter != 0 {
+		conditions = append(conditions, deviceutil.GteQuery(UpdatedAt, strconv.FormatInt(req.UpdatedAfter, 10)))
+	}
+	if req.UpdatedBefore != 0 {
+		conditions = append(conditions, deviceutil.LteQuery(UpdatedAt, strconv.FormatInt(req.UpdatedBefore, 10)))
+	}
+	return conditions, nil
+}
+
+// entitySortField maps the sort keys of the API to the entity search fields.
+func entitySortField(orderBy string) (string, error) {
+	switch orderBy {
+	case "":
+		return "", nil
+	case "name":
+		return EntityName, nil
+	case "status":
+		return OnlineStatus, nil
+	case "updated_at":
+		return UpdatedAt, nil
+	default:
+		return "", errors.Errorf("invalid order by %q", orderBy)
+	}
+}
+
 func (s *SubscribeService) CreateSubscribe(ctx context.Context, req *pb.CreateSubscribeRequest) (*pb.CreateSubscribeResponse, error) {
 	authUser, err := auth.GetUser(ctx)
 	if nil != err {
 		log.Error("get auth user err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
-	if strings.Contains(req.Title, "@") ||
-		strings.Contains(req.Title, ",") {
-		err = errors.New("title contain illegal characters")
-		log.Error("err:", err)
-		return nil, pb.ErrUnauthenticated()
-	}
 	sub := model.Subscribe{
 		UserID:      authUser.ID,
 		Title:       req.Title,
 		Description: req.Description,
 		TenantID:    authUser.TenantID,
 	}
+	if err = setDeliveryOptions(&sub, req.Mode, req.Aggregate, req.Deadband); err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInvalidArgumentSomeFields()
+	}
+	if err = setSchedule(&sub, req.ActiveSchedule, req.ExpiresAt); err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInvalidArgumentSomeFields()
+	}
+	sub.Paused = !scheduleActive(&sub, time.Now())
 
 	// TODO: lock the table
-	var count string
-	findResult := model.DB().Model(&model.Subscribe{}).Select("1").
-		Where(&model.Subscribe{UserID: authUser.ID, IsDefault: true}).
-		Limit(1).
-		Find(&count)
-	if errors.Is(
-		findResult.Error,
-		gorm.ErrRecordNotFound,
-	) || findResult.RowsAffected == 0 {
-		sub.IsDefault = true
-	}
-
-	if err = model.DB().Create(&sub).Error; err != nil {
-		log.Error("err:", err)
-		mysqlErr, ok := err.(*mysql.MySQLError)
-		if ok && mysqlErr.Number == 1062 {
+	hasDefault, err := s.repo.HasDefaultSubscribe(ctx, authUser.ID)
+	if err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInternalError()
+	}
+	sub.IsDefault = !hasDefault
+
+	if err = s.repo.CreateSubscribe(ctx, &sub); err != nil {
+		log.Error("err:", err)
+		if model.IsDuplicateKey(err) {
 			return nil, pb.ErrDuplicateCreate()
 		}
 		return nil, pb.ErrInternalError()
 	}
 
 	return &pb.CreateSubscribeResponse{
-		Id:          uint64(sub.ID),
-		Title:       sub.Title,
-		Description: sub.Description,
-		Endpoint:    sub.Endpoint,
-		IsDefault:   sub.IsDefault,
+		Id:             uint64(sub.ID),
+		Title:          sub.Title,
+		Description:    sub.Description,
+		Endpoint:       sub.Endpoint,
+		IsDefault:      sub.IsDefault,
+		Mode:           sub.Mode,
+		Aggregate:      aggregateOptionsResponse(&sub),
+		Deadband:       deadbandOptionsResponse(&sub),
+		ActiveSchedule: sub.ActiveSchedule,
+		ExpiresAt:      expiresAtResponse(&sub),
+		State:          sub.State(),
 	}, nil
 }
 
@@ -332,10 +505,9 @@ func (s *SubscribeService) UpdateSubscribe(ctx context.Context, req *pb.UpdateSu
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
-	subscribe := model.Subscribe{Model: gorm.Model{ID: uint(req.Id)}, UserID: authUser.ID}
-	validateSubscribeResult := model.DB().First(&subscribe)
-	if validateSubscribeResult.RowsAffected == 0 {
-		err = errors.Wrap(validateSubscribeResult.Error, "subscribe and user ID mismatch")
+	subscribe, err := s.repo.GetSubscribe(ctx, uint(req.Id), authUser.ID)
+	if err != nil {
+		err = errors.Wrap(err, "subscribe and user ID mismatch")
 		log.Error("err:", err)
 		return nil, pb.ErrForbidden()
 	}
@@ -345,28 +517,62 @@ func (s *SubscribeService) UpdateSubscribe(ctx context.Context, req *pb.UpdateSu
 	}
 
 	oldTitle := subscribe.Title
+	oldProcessed := subscribe.Processed()
 	subscribe.Title = req.Title
 	subscribe.Description = req.Description
+	// An empty mode keeps the delivery mode of the subscribe unchanged.
+	if req.Mode != "" {
+		if err = setDeliveryOptions(subscribe, req.Mode, req.Aggregate, req.Deadband); err != nil {
+			log.Error("err:", err)
+			return nil, pb.ErrInvalidArgumentSomeFields()
+		}
+	}
+	if err = updateSchedule(subscribe, req.ActiveSchedule, req.ExpiresAt); err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInvalidArgumentSomeFields()
+	}
 
-	if err = model.DB().Save(&subscribe).Error; err != nil {
+	if err = s.repo.SaveSubscribe(ctx, subscribe); err != nil {
 		err = errors.Wrap(err, "update subscribe info err")
 		log.Error("err:", err)
 		return nil, pb.ErrInternalError()
 	}
 
 	if oldTitle != subscribe.Title {
-		err = subscribe.UpdateEndpointTitle(oldTitle, subscribe.Title)
+		err = subscribe.UpdateEndpointTitle(ctx)
 		if err != nil {
 			log.Error(err)
 		}
 	}
 
+	if oldProcessed != subscribe.Processed() {
+		if err = subscribe.RefreshCoreSubscriptions(ctx); err != nil {
+			err = errors.Wrap(err, "refresh core subscriptions err")
+			log.Error("err:", err)
+			return nil, pb.ErrInternalError()
+		}
+	}
+
+	if paused := !scheduleActive(subscribe, time.Now()); paused != subscribe.Paused {
+		if _, err = subscribe.SetPaused(ctx, paused); err != nil {
+			err = errors.Wrap(err, "apply subscribe schedule err")
+			log.Error("err:", err)
+			return nil, pb.ErrInternalError()
+		}
+	}
+
 	resp := &pb.UpdateSubscribeResponse{
-		Id:          uint64(subscribe.ID),
-		Title:       subscribe.Title,
-		Description: subscribe.Description,
-		Endpoint:    subscribe.Endpoint,
-		IsDefault:   subscribe.IsDefault,
+		Id:             uint64(subscribe.ID),
+		Title:          subscribe.Title,
+		Description:    subscribe.Description,
+		Endpoint:       subscribe.Endpoint,
+		IsDefault:      subscribe.IsDefault,
+		Mode:           subscribe.Mode,
+		Aggregate:      aggregateOptionsResponse(subscribe),
+		Deadband:       deadbandOptionsResponse(subscribe),
+		ActiveSchedule: subscribe.ActiveSchedule,
+		ExpiresAt:      expiresAtResponse(subscribe),
+		State:          subscribe.State(),
 	}
 	return resp, nil
 }
@@ -378,13 +584,9 @@ func (s *SubscribeService) DeleteSubscribe(ctx context.Context, req *pb.DeleteSu
 		return nil, pb.ErrUnauthenticated()
 	}
 	log.Debugf("user %s starting to delete subscribe %d", authUser.ID, req.Id)
-	subscribe := model.Subscribe{}
-	validateSubscribeResult := model.DB().Model(&subscribe).
-		Where("id = ?", req.Id).
-		Where("user_id = ?", authUser.ID).
-		First(&subscribe)
-	if validateSubscribeResult.RowsAffected == 0 {
-		err = errors.Wrap(validateSubscribeResult.Error, "subscribe and user ID mismatch")
+	subscribe, err := s.repo.GetSubscribe(ctx, uint(req.Id), authUser.ID)
+	if err != nil {
+		err = errors.Wrap(err, "subscribe and user ID mismatch")
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
@@ -393,7 +595,7 @@ func (s *SubscribeService) DeleteSubscribe(ctx context.Context, req *pb.DeleteSu
 		return nil, pb.ErrDefaultSubscribeUnableToModify()
 	}
 
-	if err = model.DB().Delete(&subscribe).Error; err != nil {
+	if err = s.repo.DeleteSubscribe(ctx, subscribe); err != nil {
 		if errors.Is(err, model.ErrUndeleteable) {
 			return nil, pb.ErrTryToDeleteDefaultSubscribe()
 		}
@@ -411,26 +613,35 @@ func (s *SubscribeService) GetSubscribe(ctx context.Context, req *pb.GetSubscrib
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
-	subscribe := model.Subscribe{Model: gorm.Model{ID: uint(req.Id)}, UserID: authUser.ID}
-	validateSubscribeResult := model.DB().First(&subscribe)
-	if validateSubscribeResult.RowsAffected == 0 {
-		err = errors.Wrap(validateSubscribeResult.Error, "subscribe and user ID mismatch")
+	subscribe, err := s.repo.GetSubscribe(ctx, uint(req.Id), authUser.ID)
+	if err != nil {
+		err = errors.Wrap(err, "subscribe and user ID mismatch")
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
 
-	var count int64
-	model.DB().Model(&model.SubscribeEntities{}).Where("subscribe_id = ?", subscribe.ID).Count(&count)
+	count, err := s.repo.CountEntities(ctx, subscribe.ID)
+	if err != nil {
+		log.Error("count subscribe entities err:", err)
+		return nil, pb.ErrInternalError()
+	}
 
 	resp := &pb.GetSubscribeResponse{
-		Id:          uint64(subscribe.ID),
-		Title:       subscribe.Title,
-		Description: subscribe.Description,
-		Endpoint:    model.AMQPAddressString(subscribe.Endpoint),
-		Count:       uint64(count),
-		CreatedAt:   subscribe.CreatedAt.Unix(),
-		UpdatedAt:   subscribe.UpdatedAt.Unix(),
-		IsDefault:   subscribe.IsDefault,
+		Id:             uint64(subscribe.ID),
+		Title:          subscribe.Title,
+		Description:    subscribe.Description,
+		Endpoint:       model.AMQPAddressString(subscribe.Endpoint),
+		Count:          uint64(count),
+		CreatedAt:      subscribe.CreatedAt.Unix(),
+		UpdatedAt:      subscribe.UpdatedAt.Unix(),
+		IsDefault:      subscribe.IsDefault,
+		Mode:           subscribe.Mode,
+		Aggregate:      aggregateOptionsResponse(subscribe),
+		Deadband:       deadbandOptionsResponse(subscribe),
+		ActiveSchedule: subscribe.ActiveSchedule,
+		ExpiresAt:      expiresAtResponse(subscribe),
+		State:          subscribe.State(),
+		Query:          queryConditionsResponse(subscribe),
 	}
 	return resp, nil
 }
@@ -446,16 +657,9 @@ func (s *SubscribeService) ListSubscribe(ctx context.Context, req *pb.ListSubscr
 		log.Error("parse request page info error:", err)
 		return nil, pb.ErrInvalidArgument()
 	}
-	var subscribes []model.Subscribe
-	result := &gorm.DB{Error: errors.New("db query error")}
-	subscribeCondition := model.Subscribe{UserID: authUser.ID}
-	if page.Required() {
-		result = model.Paginate(&subscribes, page, &subscribeCondition)
-	} else {
-		result = model.ListAll(&subscribes, &subscribeCondition)
-	}
-	if result.Error != nil {
-		log.Error("err:", result.Error)
+	subscribes, count, err := s.repo.ListSubscribes(ctx, authUser.ID, page)
+	if err != nil {
+		log.Error("err:", err)
 		return nil, pb.ErrInternalError()
 	}
 
@@ -469,6 +673,8 @@ func (s *SubscribeService) ListSubscribe(ctx context.Context, req *pb.ListSubscr
 			Description: subscribes[i].Description,
 			Endpoint:    model.AMQPAddressString(subscribes[i].Endpoint),
 			IsDefault:   subscribes[i].IsDefault,
+			Mode:        subscribes[i].Mode,
+			State:       subscribes[i].State(),
 		})
 	}
 
@@ -490,17 +696,11 @@ func (s *SubscribeService) ListSubscribe(ctx context.Context, req *pb.ListSubscr
 			Description: subscribeResponse.Description,
 			Endpoint:    model.AMQPAddressString(subscribeResponse.Endpoint),
 			IsDefault:   subscribeResponse.IsDefault,
+			Mode:        subscribeResponse.Mode,
+			State:       subscribeResponse.State,
 		})
 	}
 
-	var count int64
-	if err = model.Count(&count, &subscribeCondition, &subscribeCondition).Error; err != nil {
-		if !errors.Is(err, gorm.ErrRecordNotFound) {
-			log.Error("err:", err)
-			return nil, err
-		}
-		count = 0
-	}
 	page.SetTotal(uint(count))
 	if err = page.FillResponse(resp); err != nil {
 		log.Error("err:", err)
@@ -526,58 +726,64 @@ func (s *SubscribeService) ChangeSubscribed(ctx context.Context, req *pb.ChangeS
 		return nil, pb.ErrInvalidArgumentSomeFields()
 	}
 
-	subscribe := model.Subscribe{Model: gorm.Model{ID: uint(req.Id)}, UserID: authUser.ID}
-	validateSubscribeResult := model.DB().First(&subscribe)
-	if validateSubscribeResult.RowsAffected == 0 {
-		err = errors.Wrap(validateSubscribeResult.Error, "subscribe and user ID mismatch")
+	subscribe, err := s.repo.GetSubscribe(ctx, uint(req.Id), authUser.ID)
+	if err != nil {
+		err = errors.Wrap(err, "subscribe and user ID mismatch")
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
-	targetSubscribe := model.Subscribe{Model: gorm.Model{ID: uint(req.TargetId)}, UserID: authUser.ID}
-	validateSubscribeResult = model.DB().First(&targetSubscribe)
-	if validateSubscribeResult.RowsAffected == 0 {
-		err = errors.Wrap(validateSubscribeResult.Error, "subscribe and user ID mismatch")
+	targetSubscribe, err := s.repo.GetSubscribe(ctx, uint(req.TargetId), authUser.ID)
+	if err != nil {
+		err = errors.Wrap(err, "subscribe and user ID mismatch")
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
 
-	errs := []error{}
-	for i := range req.SelectedIds {
-		entityID := req.SelectedIds[i]
-		subscribeEntity := model.SubscribeEntities{
-			SubscribeID: subscribe.ID,
-			Subscribe:   subscribe,
-			EntityID:    entityID,
-			UniqueKey:   subscribeuril.GenerateSubscribeTopic(subscribe.ID, entityID),
-		}
-		targetSubscribeEntity := model.SubscribeEntities{
-			Subscribe:   targetSubscribe,
-			SubscribeID: targetSubscribe.ID,
-			EntityID:    entityID,
-			UniqueKey:   subscribeuril.GenerateSubscribeTopic(targetSubscribe.ID, entityID),
-		}
-		if err := model.DB().Debug().Where(targetSubscribeEntity).First(&targetSubscribeEntity).Error; !errors.Is(err, gorm.ErrRecordNotFound) {
-			errs = append(errs, errors.New("target subscribe entity already exists"))
-			_ = model.DB().Where("unique_key = ?", subscribeEntity.UniqueKey).Delete(&subscribeEntity)
-			continue
-		}
-		if err = model.DB().Debug().Model(&subscribeEntity).Where(subscribeEntity).Updates(targetSubscribeEntity).Error; err != nil {
-			errs = append(errs, err)
-		}
+	if subscribe.ID == targetSubscribe.ID {
+		return nil, pb.ErrInvalidArgumentSomeFields()
 	}
 
-	if len(errs) == len(req.SelectedIds) && len(errs) > 0 {
-		err = errors.Wrap(errs[0], "change subscribed failed")
-		log.Error("err:", err)
-		log.Error("more err form db process:", errs)
-		//		return nil, pb.ErrInternalError()
+	var move bool
+	switch req.Mode {
+	case "", _ChangeSubscribedMove:
+		move = true
+	case _ChangeSubscribedCopy:
+	default:
+		return nil, pb.ErrInvalidArgumentSomeFields()
 	}
 
 	resp := &pb.ChangeSubscribedResponse{Status: SuccessStatus}
-	if len(errs) != 0 {
+	failures := 0
+	for _, entityID := range req.SelectedIds {
+		result := &pb.EntityResult{Id: entityID, Status: SuccessStatus}
+		resp.Results = append(resp.Results, result)
+		err = model.TransferEntity(ctx, entityID, subscribe, targetSubscribe, move, req.Rollback)
+		if err == nil {
+			continue
+		}
 		resp.Status = ErrPartialFailure
+		var syncErr *model.ErrCoreSync
+		switch {
+		case errors.Is(err, model.ErrNotSubscribed):
+			result.Status = _EntityNotSubscribed
+		case errors.Is(err, model.ErrAlreadySubscribed):
+			result.Status = _EntityAlreadySubscribed
+		case errors.As(err, &syncErr):
+			log.Errorf("change subscribed entity %s, core out of sync: %v", entityID, err)
+			result.Status = _EntityCoreOutOfSync
+			result.Message = err.Error()
+		default:
+			log.Errorf("change subscribed entity %s err: %v", entityID, err)
+			result.Status = _EntityFailure
+			result.Message = err.Error()
+			failures++
+		}
 	}
 
+	if failures == len(req.SelectedIds) {
+		log.Error("change subscribed failed for all entities")
+		return nil, pb.ErrInternalError()
+	}
 	return resp, nil
 }
 
@@ -591,11 +797,8 @@ func (s *SubscribeService) ValidateSubscribed(ctx context.Context, req *pb.Valid
 		return nil, pb.ErrInvalidArgumentSomeFields()
 	}
 
-	subscribe := model.Subscribe{Endpoint: req.Topic, UserID: authUser.ID}
-	var find model.Subscribe
-	validateSubscribeResult := model.DB().Debug().Model(&subscribe).Select("id").Where(&subscribe).Find(&find)
-	if validateSubscribeResult.RowsAffected == 0 || validateSubscribeResult.Error != nil {
-		err = errors.New("subscribe and user mismatch")
+	if _, err = s.repo.GetSubscribeByEndpoint(ctx, req.Topic, authUser.ID); err != nil {
+		err = errors.Wrap(err, "subscribe and user mismatch")
 		log.Error("invalid error:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
@@ -627,24 +830,14 @@ func (s *SubscribeService) SubscribeByDevice(ctx context.Context, req *pb.Subscr
 		}
 	}
 
-	var find []model.Subscribe
-	validateSubscribeResult := model.DB().Model(&model.Subscribe{}).
-		Select("id").
-		Where("id IN ?", req.SubscribeIds).
-		Where("user_id = ?", authUser.ID).Find(&find)
-	if validateSubscribeResult.RowsAffected != int64(len(req.SubscribeIds)) {
-		err = errors.Wrap(validateSubscribeResult.Error, "device and user mismatch")
+	owned, err := s.repo.CountOwnedSubscribes(ctx, subIDs, authUser.ID)
+	if err != nil || owned != int64(len(req.SubscribeIds)) {
+		err = errors.Wrap(err, "device and user mismatch")
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
-	//	subscribeEntities := make([]model.SubscribeEntities, len(req.SubscribeIds))
 	for i := range subIDs {
-		subscribeEntity := model.SubscribeEntities{
-			SubscribeID: subIDs[i],
-			EntityID:    req.Id,
-			UniqueKey:   subscribeuril.GenerateSubscribeTopic(subIDs[i], req.Id),
-		}
-		if err = model.DB().Debug().Create(&subscribeEntity).Error; err != nil {
+		if err = s.repo.AddEntity(ctx, subIDs[i], req.Id); err != nil {
 			log.Error("create err:", err)
 			//	return nil, pb.ErrInternalError()
 		}
@@ -654,25 +847,153 @@ func (s *SubscribeService) SubscribeByDevice(ctx context.Context, req *pb.Subscr
 	return resp, nil
 }
 
-// createSubscribeEntitiesRecords create SubscribeEntities(subscribe_entities table) records.
-func (s *SubscribeService) createSubscribeEntitiesRecords(entityIDs []string, subscribe *model.Subscribe) []*model.SubscribeEntities {
-	records := make([]*model.SubscribeEntities, 0, len(entityIDs))
-	for _, entityID := range entityIDs {
-		subscribeEntity := model.SubscribeEntities{
-			Subscribe: *subscribe,
-			EntityID:  entityID,
-			UniqueKey: subscribeuril.GenerateSubscribeTopic(subscribe.ID, entityID),
+// setDeliveryOptions validates the requested delivery mode of a subscribe and
+// sets it with its options, filling their defaults.
+func setDeliveryOptions(subscribe *model.Subscribe, mode string, aggregate *pb.AggregateOptions, deadband *pb.DeadbandOptions) error {
+	subscribe.Aggregate = model.AggregateOptions{}
+	subscribe.Deadband = model.DeadbandOptions{}
+	switch mode {
+	case "", model.ModeRealtime:
+		subscribe.Mode = model.ModeRealtime
+		return nil
+	case model.ModeAggregate:
+		if aggregate == nil || aggregate.Window == 0 {
+			return errors.New("aggregate window is required")
 		}
-		records = append(records, &subscribeEntity)
+		subscribe.Aggregate.Window = aggregate.Window
+		subscribe.Aggregate.Lateness = aggregate.Lateness
+		switch aggregate.Scope {
+		case "", model.AggregateScopeEntity:
+			subscribe.Aggregate.Scope = model.AggregateScopeEntity
+		case model.AggregateScopeSubscribe:
+			subscribe.Aggregate.Scope = model.AggregateScopeSubscribe
+		default:
+			return errors.Errorf("unknown aggregate scope %q", aggregate.Scope)
+		}
+	case model.ModeOnChange:
+		if deadband != nil {
+			if deadband.Value < 0 {
+				return errors.New("deadband value must not be negative")
+			}
+			subscribe.Deadband.Value = deadband.Value
+			switch deadband.Type {
+			case "", model.DeadbandAbsolute:
+				subscribe.Deadband.Type = model.DeadbandAbsolute
+			case model.DeadbandPercent:
+				subscribe.Deadband.Type = model.DeadbandPercent
+			default:
+				return errors.Errorf("unknown deadband type %q", deadband.Type)
+			}
+		}
+	default:
+		return errors.Errorf("unknown subscribe mode %q", mode)
+	}
+	subscribe.Mode = mode
+	return nil
+}
+
+// setSchedule validates and sets the active schedule and the expiry of a
+// subscribe, an expiresAt of 0 means the subscribe never expires.
+func setSchedule(subscribe *model.Subscribe, activeSchedule string, expiresAt int64) error {
+	if activeSchedule != "" {
+		if _, err := schedule.Parse(activeSchedule); err != nil {
+			return err
+		}
+	}
+	subscribe.ActiveSchedule = activeSchedule
+
+	if expiresAt == 0 {
+		subscribe.ExpiresAt = nil
+		return nil
+	}
+	expires := time.Unix(expiresAt, 0)
+	if subscribe.ExpiresAt != nil && subscribe.ExpiresAt.Equal(expires) {
+		return nil
+	}
+	if !expires.After(time.Now()) {
+		return errors.New("expires_at is not in the future")
+	}
+	subscribe.ExpiresAt = &expires
+	return nil
+}
+
+const (
+	// clearActiveSchedule removes the active schedule of a subscribe on update.
+	clearActiveSchedule = "-"
+	// clearExpiresAt removes the expiry of a subscribe on update.
+	clearExpiresAt = -1
+)
+
+// updateSchedule validates and sets the schedule of an updated subscribe, an
+// empty active schedule or expiry keeps the current one.
+func updateSchedule(subscribe *model.Subscribe, activeSchedule string, expiresAt int64) error {
+	switch activeSchedule {
+	case "":
+		activeSchedule = subscribe.ActiveSchedule
+	case clearActiveSchedule:
+		activeSchedule = ""
+	}
+	switch expiresAt {
+	case 0:
+		expiresAt = expiresAtResponse(subscribe)
+	case clearExpiresAt:
+		expiresAt = 0
+	}
+	return setSchedule(subscribe, activeSchedule, expiresAt)
+}
+
+// scheduleActive reports whether the subscribe is within its active schedule,
+// the schedule has been validated by setSchedule.
+func scheduleActive(subscribe *model.Subscribe, now time.Time) bool {
+	if subscribe.ActiveSchedule == "" {
+		return true
+	}
+	activeSchedule, err := schedule.Parse(subscribe.ActiveSchedule)
+	if err != nil {
+		return true
+	}
+	return schedule.Active(activeSchedule, now)
+}
+
+func expiresAtResponse(subscribe *model.Subscribe) int64 {
+	if subscribe.ExpiresAt == nil {
+		return 0
+	}
+	return subscribe.ExpiresAt.Unix()
+}
+
+func aggregateOptionsResponse(subscribe *model.Subscribe) *pb.AggregateOptions {
+	if subscribe.Mode != model.ModeAggregate {
+		return nil
+	}
+	return &pb.AggregateOptions{
+		Window:   subscribe.Aggregate.Window,
+		Scope:    subscribe.Aggregate.Scope,
+		Lateness: subscribe.Aggregate.Lateness,
+	}
+}
+
+func deadbandOptionsResponse(subscribe *model.Subscribe) *pb.DeadbandOptions {
+	if subscribe.Mode != model.ModeOnChange {
+		return nil
+	}
+	return &pb.DeadbandOptions{
+		Type:  subscribe.Deadband.Type,
+		Value: subscribe.Deadband.Value,
 	}
-	return records
 }
 
-func (s *SubscribeService) getDeviceEntitiesIDsFromGroups(ctx context.Context, groups []string, token, auth string) ([]string, error) {
+// getDeviceEntitiesIDsFromGroups lists the devices of the groups, with
+// includeSubgroups the devices of their sub-groups as well.
+func (s *SubscribeService) getDeviceEntitiesIDsFromGroups(ctx context.Context, groups []string, includeSubgroups bool, token, auth string) ([]string, error) {
 	var data []string
 	dc := deviceutil.NewClient(token, auth)
 	for i := range groups {
-		bytes, err := dc.Search(deviceutil.DeviceSearch, deviceutil.Conditions{deviceutil.GroupQuery(groups[i]), deviceutil.DeviceTypeQuery()})
+		groupQuery := deviceutil.EqQuery(ParentID, groups[i])
+		if includeSubgroups {
+			groupQuery = deviceutil.GroupQuery(groups[i])
+		}
+		bytes, err := dc.Search(s.devices.DeviceSearch, deviceutil.Conditions{groupQuery, deviceutil.DeviceTypeQuery()})
 		if err != nil {
 			log.Error("query device by device group err:", err)
 			return nil, err
@@ -695,7 +1016,7 @@ func (s *SubscribeService) getDeviceEntitiesIDsFromTemplates(ctx context.Context
 	var data []string
 	dc := deviceutil.NewClient(token, auth)
 	for i := range templates {
-		bytes, err := dc.Search(deviceutil.DeviceSearch, deviceutil.Conditions{deviceutil.TemplateQuery(templates[i])})
+		bytes, err := dc.Search(s.devices.DeviceSearch, deviceutil.Conditions{deviceutil.TemplateQuery(templates[i])})
 		if err != nil {
 			log.Error("query device by device group err:", err)
 			return nil, err
@@ -713,11 +1034,41 @@ func (s *SubscribeService) getDeviceEntitiesIDsFromTemplates(ctx context.Context
 	return data, nil
 }
 
-func (s SubscribeService) deviceEntities(ids []string, token, auth string) ([]*pb.Entity, error) {
-	entities := make([]*pb.Entity, 0, len(ids))
-	client := deviceutil.NewClient(token, auth)
+// subscribeEntities returns the page of the entities of the subscribe.
+func (s SubscribeService) subscribeEntities(ctx context.Context, subscribe *model.Subscribe, scope, token, auth string, page *pagination.Page) ([]*pb.Entity, error) {
+	ids, total, err := s.repo.PageEntityIDs(ctx, subscribe.ID, int(page.Offset()), int(page.Limit()))
+	if err != nil {
+		log.Error("list subscribe entities err:", err)
+		return nil, err
+	}
+	page.SetTotal(uint(total))
+	return s.deviceEntities(scope, ids, token, auth)
+}
+
+// deviceEntities returns the entities of the IDs in order, the metadata not
+// cached in the scope is searched in batches of _deviceSearchBatchSize. The
+// entities the device service no longer knows are left out.
+func (s SubscribeService) deviceEntities(scope string, ids []string, token, auth string) ([]*pb.Entity, error) {
+	found := make(map[string]deviceutil.EntityMetadata, len(ids))
+	missing := make([]string, 0)
 	for _, id := range ids {
-		bytes, err := client.Search(deviceutil.EntitySearch, deviceutil.Conditions{deviceutil.DeviceQuery(id)})
+		if metadata, ok := s.entities.Get(scope, id); ok {
+			found[id] = metadata
+			continue
+		}
+		missing = append(missing, id)
+	}
+
+	client := deviceutil.NewClient(token, auth)
+	for start := 0; start < len(missing); start += _deviceSearchBatchSize {
+		end := start + _deviceSearchBatchSize
+		if end > len(missing) {
+			end = len(missing)
+		}
+		batch := missing[start:end]
+		bytes, err := client.Search(s.devices.EntitySearch,
+			deviceutil.Conditions{deviceutil.InQuery("id", batch...)},
+			deviceutil.WithPagination(1, int32(len(batch))))
 		if err != nil {
 			log.Error("query device by device id err:", err)
 			return nil, err
@@ -727,32 +1078,53 @@ func (s SubscribeService) deviceEntities(ids []string, token, auth string) ([]*p
 			log.Error("parse device search response err:", err)
 			return nil, err
 		}
-		if len(resp.Data.Items) == 0 {
-			log.Error("device not found:", id)
-			return nil, ErrDeviceNotFound
-		}
-		entity := &pb.Entity{
-			ID:        id,
-			Name:      resp.Data.Items[0].Properties.BasicInfo.Name,
-			Template:  resp.Data.Items[0].Properties.BasicInfo.TemplateName,
-			Group:     resp.Data.Items[0].Properties.BasicInfo.ParentName,
-			Status:    "offline",
-			UpdatedAt: resp.Data.Items[0].Properties.SysField.UpdatedAt,
+		for i := range resp.Data.Items {
+			metadata := deviceutil.NewEntityMetadata(&resp.Data.Items[i])
+			s.entities.Add(scope, metadata)
+			found[metadata.ID] = metadata
 		}
-		if resp.Data.Items[0].Properties.ConnectionInfo.IsOnline {
-			entity.Status = "online"
+	}
+
+	entities := make([]*pb.Entity, 0, len(ids))
+	for _, id := range ids {
+		metadata, ok := found[id]
+		if !ok {
+			log.Warn("device not found:", id)
+			continue
 		}
-		entities = append(entities, entity)
+		entities = append(entities, entityResponse(metadata))
 	}
 	return entities, nil
 }
 
-func (s SubscribeService) getEntitiesByConditions(conditions deviceutil.Conditions, token, auth string, page *pagination.Page) ([]*pb.Entity, error) {
+func entityResponse(metadata deviceutil.EntityMetadata) *pb.Entity {
+	entity := &pb.Entity{
+		ID:         metadata.ID,
+		Name:       metadata.Name,
+		Template:   metadata.Template,
+		TemplateId: metadata.TemplateID,
+		Group:      metadata.Group,
+		Status:     "offline",
+		UpdatedAt:  metadata.UpdatedAt,
+	}
+	if metadata.Online {
+		entity.Status = "online"
+	}
+	return entity
+}
+
+func (s SubscribeService) getEntitiesByConditions(conditions deviceutil.Conditions, scope, token, auth string, page *pagination.Page) ([]*pb.Entity, error) {
 	client := deviceutil.NewClient(token, auth)
 	entities := make([]*pb.Entity, 0)
 
-	bytes, err := client.Search(deviceutil.EntitySearch, conditions,
-		deviceutil.WithQuery(page.KeyWords), deviceutil.WithPagination(int32(page.Num), int32(page.Size)))
+	options := []deviceutil.RequestOption{
+		deviceutil.WithQuery(page.KeyWords),
+		deviceutil.WithPagination(int32(page.Num), int32(page.Size)),
+	}
+	if page.OrderBy != "" {
+		options = append(options, deviceutil.WithOrder(page.OrderBy, page.IsDescending))
+	}
+	bytes, err := client.Search(s.devices.EntitySearch, conditions, options...)
 	if err != nil {
 		log.Error("query device by device id err:", err)
 		return nil, err
@@ -768,20 +1140,10 @@ func (s SubscribeService) getEntitiesByConditions(conditions deviceutil.Conditio
 	//	}
 	page.SetTotal(uint(resp.Data.Total))
 
-	for _, item := range resp.Data.Items {
-		entity := &pb.Entity{
-			ID:         item.Id,
-			Name:       item.Properties.BasicInfo.Name,
-			Template:   item.Properties.BasicInfo.TemplateName,
-			TemplateId: item.Properties.BasicInfo.TemplateID,
-			Group:      item.Properties.BasicInfo.ParentName,
-			Status:     "offline",
-			UpdatedAt:  item.Properties.SysField.UpdatedAt,
-		}
-		if item.Properties.ConnectionInfo.IsOnline {
-			entity.Status = "online"
-		}
-		entities = append(entities, entity)
+	for i := range resp.Data.Items {
+		metadata := deviceutil.NewEntityMetadata(&resp.Data.Items[i])
+		s.entities.Add(scope, metadata)
+		entities = append(entities, entityResponse(metadata))
 	}
 	return entities, nil
 }
@@ -3,6 +3,10 @@ package service
 import (
 	"fmt"
 	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	pb "github.com/tkeel-io/core-broker/api/subscribe/v1"
+	"github.com/tkeel-io/core-broker/pkg/config"
+	"github.com/tkeel-io/core-broker/pkg/deviceutil"
 	"github.com/tkeel-io/core-broker/pkg/model"
 	"github.com/tkeel-io/core-broker/pkg/pagination"
 	"gorm.io/gorm"
@@ -12,7 +16,13 @@ import (
 
 func TestDB(t *testing.T) {
 	os.Setenv("DSN", "root:a3fks=ixmeb82a@tcp(192.168.123.9:31815)/core-broker?charset=utf8mb4&parseTime=True&loc=Local")
-	model.Setup()
+	c, err := config.Load("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = model.Setup(model.Options{Database: c.Database, AMQPServer: c.AMQPServer}); err != nil {
+		t.Skip("database unavailable:", err)
+	}
 
 	page := pagination.Page{
 		Num:          1,
@@ -43,3 +53,41 @@ func TestDB(t *testing.T) {
 	}
 
 }
+
+func TestEntityFilters(t *testing.T) {
+	conditions, err := entityFilters(&pb.ListSubscribeEntitiesRequest{
+		Status:        "online",
+		TemplateId:    "iotd-template",
+		GroupId:       "iotd-group",
+		UpdatedAfter:  1650000000000,
+		UpdatedBefore: 1650086400000,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, deviceutil.Conditions{
+		deviceutil.EqQuery(OnlineStatus, "true"),
+		deviceutil.EqQuery(TemplateID, "iotd-template"),
+		deviceutil.EqQuery(ParentID, "iotd-group"),
+		deviceutil.GteQuery(UpdatedAt, "1650000000000"),
+		deviceutil.LteQuery(UpdatedAt, "1650086400000"),
+	}, conditions)
+
+	conditions, err = entityFilters(&pb.ListSubscribeEntitiesRequest{GroupId: "iotd-group", IncludeSubgroups: true})
+	assert.Nil(t, err)
+	assert.Equal(t, deviceutil.Conditions{deviceutil.GroupQuery("iotd-group")}, conditions)
+
+	_, err = entityFilters(&pb.ListSubscribeEntitiesRequest{Status: "unknown"})
+	assert.NotNil(t, err)
+	_, err = entityFilters(&pb.ListSubscribeEntitiesRequest{UpdatedAfter: 2, UpdatedBefore: 1})
+	assert.NotNil(t, err)
+}
+
+func TestEntitySortField(t *testing.T) {
+	field, err := entitySortField("updated_at")
+	assert.Nil(t, err)
+	assert.Equal(t, UpdatedAt, field)
+	field, err = entitySortField("")
+	assert.Nil(t, err)
+	assert.Equal(t, "", field)
+	_, err = entitySortField("basicInfo.name; drop")
+	assert.NotNil(t, err)
+}
@@ -19,7 +19,11 @@ package service
 import (
 	"context"
 
+	"github.com/pkg/errors"
 	pb "github.com/tkeel-io/core-broker/api/topic/v1"
+	"github.com/tkeel-io/core-broker/pkg/delivery"
+	"github.com/tkeel-io/core-broker/pkg/deviceutil"
+	"github.com/tkeel-io/core-broker/pkg/model"
 	"github.com/tkeel-io/core-broker/pkg/types"
 	"github.com/tkeel-io/kit/log"
 )
@@ -35,14 +39,77 @@ const (
 
 type TopicService struct {
 	pb.UnimplementedTopicServer
+	processor          *delivery.Processor
+	entities           *deviceutil.EntityCache
+	entityDeletedTopic string
 }
 
-func NewTopicService() *TopicService {
-	return &TopicService{}
+// NewTopicService handles the events of the deleted entities published to
+// entityDeletedTopic, an empty one has no events. The events change the
+// metadata of their entities cached in entities.
+func NewTopicService(processor *delivery.Processor, entities *deviceutil.EntityCache, entityDeletedTopic string) *TopicService {
+	return &TopicService{processor: processor, entities: entities, entityDeletedTopic: entityDeletedTopic}
 }
 
 func (s *TopicService) TopicEventHandler(ctx context.Context, req *pb.TopicEventRequest) (*pb.TopicEventResponse, error) {
+	switch {
+	case req.Topic == types.DeliveryTopic:
+		return s.deliveryEventHandler(req), nil
+	case s.entityDeletedTopic != "" && req.Topic == s.entityDeletedTopic:
+		return s.entityDeletedEventHandler(ctx, req), nil
+	}
+	s.invalidateEntityMetadata(req)
 	types.MsgChan <- req
 	log.Debug("topic event", req)
 	return &pb.TopicEventResponse{Status: SubscriptionResponseStatusSuccess}, nil
 }
+
+func (s *TopicService) deliveryEventHandler(req *pb.TopicEventRequest) *pb.TopicEventResponse {
+	err := s.processor.Handle(req)
+	switch {
+	case err == nil:
+		return &pb.TopicEventResponse{Status: SubscriptionResponseStatusSuccess}
+	case errors.Is(err, delivery.ErrUnknownSubscription), errors.Is(err, delivery.ErrLateEvent):
+		log.Debug("drop delivery event:", err)
+		return &pb.TopicEventResponse{Status: SubscriptionResponseStatusDrop}
+	default:
+		log.Error("handle delivery event err:", err)
+		return &pb.TopicEventResponse{Status: SubscriptionResponseStatusRetry}
+	}
+}
+
+// entityDeletedEventHandler removes the memberships of a deleted entity, the
+// event data carries the ID of the entity.
+func (s *TopicService) entityDeletedEventHandler(ctx context.Context, req *pb.TopicEventRequest) *pb.TopicEventResponse {
+	kv, ok := req.Data.AsInterface().(map[string]interface{})
+	if !ok {
+		log.Error("drop entity deleted event with invalid data:", req.Data)
+		return &pb.TopicEventResponse{Status: SubscriptionResponseStatusDrop}
+	}
+	entityID := types.Interface2string(kv["id"])
+	if entityID == "" {
+		log.Error("drop entity deleted event without entity ID:", req.Data)
+		return &pb.TopicEventResponse{Status: SubscriptionResponseStatusDrop}
+	}
+	s.entities.Remove(entityID)
+	removed, err := model.RemoveDeletedEntity(ctx, entityID)
+	if err != nil {
+		log.Errorf("remove deleted entity %s err: %v", entityID, err)
+		return &pb.TopicEventResponse{Status: SubscriptionResponseStatusRetry}
+	}
+	if removed != 0 {
+		log.Infof("removed %d memberships of deleted entity %s", removed, entityID)
+	}
+	return &pb.TopicEventResponse{Status: SubscriptionResponseStatusSuccess}
+}
+
+// invalidateEntityMetadata removes the cached metadata of the entity when the
+// event changes one of its metadata properties.
+func (s *TopicService) invalidateEntityMetadata(req *pb.TopicEventRequest) {
+	kv, ok := req.Data.AsInterface().(map[string]interface{})
+	if !ok {
+		return
+	}
+	properties, _ := kv["properties"].(map[string]interface{})
+	s.entities.Invalidate(types.GetEntityID(types.Interface2string(kv["id"])), properties)
+}
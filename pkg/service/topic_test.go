@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	pb "github.com/tkeel-io/core-broker/api/topic/v1"
+	"github.com/tkeel-io/core-broker/pkg/config"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestEntityDeletedEventHandlerDrop(t *testing.T) {
+	s := NewTopicService(nil, nil, "entity-deleted")
+	data, err := structpb.NewValue(map[string]interface{}{"owner": "usr-1"})
+	assert.Nil(t, err)
+	resp := s.entityDeletedEventHandler(context.Background(), &pb.TopicEventRequest{Topic: "entity-deleted", Data: data})
+	assert.Equal(t, SubscriptionResponseStatusDrop, resp.Status)
+
+	resp = s.entityDeletedEventHandler(context.Background(), &pb.TopicEventRequest{Topic: "entity-deleted", Data: structpb.NewStringValue("iotd-1")})
+	assert.Equal(t, SubscriptionResponseStatusDrop, resp.Status)
+}
+
+func TestDaprSubscribeEntityDeletedTopic(t *testing.T) {
+	dapr := config.Default().Dapr
+	resp, err := NewDaprSubscribeService(dapr).GetSubscribe(context.Background(), nil)
+	assert.Nil(t, err)
+	for _, subscription := range resp.Subscriptions {
+		assert.NotEqual(t, "", subscription.Topic)
+	}
+	without := len(resp.Subscriptions)
+
+	dapr.EntityDeletedTopic = "device-deleted"
+	resp, err = NewDaprSubscribeService(dapr).GetSubscribe(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Len(t, resp.Subscriptions, without+1)
+	assert.Equal(t, "device-deleted", resp.Subscriptions[without].Topic)
+}
@@ -0,0 +1,119 @@
+package subscribeuril
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// SubscribeAddr is an entry of the _subscribeAddr of an entity, one per
+// subscribe the entity is a member of.
+type SubscribeAddr struct {
+	SubscribeID uint   `json:"subscribe_id"`
+	Title       string `json:"title"`
+	// Endpoint is the AMQP address the subscribe is delivered to.
+	Endpoint string `json:"endpoint"`
+}
+
+// SubscribeAddrs is the value of the _subscribeAddr of an entity, stored as
+// a JSON list.
+type SubscribeAddrs []SubscribeAddr
+
+// ParseSubscribeAddrs reads the _subscribeAddr of an entity, either the JSON
+// list or the legacy comma joined title@id@endpoint entries.
+func ParseSubscribeAddrs(value string) (SubscribeAddrs, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return SubscribeAddrs{}, nil
+	}
+	if strings.HasPrefix(value, "[") {
+		addrs := SubscribeAddrs{}
+		if err := json.Unmarshal([]byte(value), &addrs); err != nil {
+			return nil, errors.Wrap(err, "decode subscribe addresses")
+		}
+		return addrs, nil
+	}
+	return parseLegacySubscribeAddrs(value)
+}
+
+// The titles of the legacy entries can contain neither "@" nor ",".
+func parseLegacySubscribeAddrs(value string) (SubscribeAddrs, error) {
+	entries := strings.Split(value, ",")
+	addrs := make(SubscribeAddrs, 0, len(entries))
+	for _, entry := range entries {
+		if entry == "" {
+			continue
+		}
+		parts := strings.SplitN(entry, "@", 3)
+		if len(parts) != 3 {
+			return nil, errors.Errorf("invalid subscribe address %q", entry)
+		}
+		id, err := strconv.ParseUint(parts[1], 10, 0)
+		if err != nil {
+			return nil, errors.Errorf("invalid subscribe ID of subscribe address %q", entry)
+		}
+		addrs = append(addrs, SubscribeAddr{SubscribeID: uint(id), Title: parts[0], Endpoint: parts[2]})
+	}
+	return addrs, nil
+}
+
+// String is the JSON list, empty if there is no entry so that entities
+// without subscribes keep an empty _subscribeAddr.
+func (a SubscribeAddrs) String() string {
+	if len(a) == 0 {
+		return ""
+	}
+	content, _ := json.Marshal(a)
+	return string(content)
+}
+
+// Legacy is the comma joined title@id@endpoint representation. It fails if
+// a title contains "@" or ",", which the legacy entries cannot represent.
+func (a SubscribeAddrs) Legacy() (string, error) {
+	entries := make([]string, 0, len(a))
+	for _, addr := range a {
+		if !IsLegacyTitle(addr.Title) {
+			return "", errors.Errorf("title %q of subscribe %d contains \"@\" or \",\"", addr.Title, addr.SubscribeID)
+		}
+		entries = append(entries, strings.Join([]string{
+			addr.Title, strconv.FormatUint(uint64(addr.SubscribeID), 10), addr.Endpoint,
+		}, "@"))
+	}
+	return strings.Join(entries, ","), nil
+}
+
+// IsLegacyTitle reports whether the title can be represented by the legacy
+// entries.
+func IsLegacyTitle(title string) bool {
+	return !strings.ContainsAny(title, "@,")
+}
+
+// Add adds or replaces the entry of the subscribe, it reports whether the
+// addresses have changed.
+func (a *SubscribeAddrs) Add(addr SubscribeAddr) bool {
+	for i := range *a {
+		if (*a)[i].SubscribeID == addr.SubscribeID {
+			if (*a)[i] == addr {
+				return false
+			}
+			(*a)[i] = addr
+			return true
+		}
+	}
+	*a = append(*a, addr)
+	return true
+}
+
+// Remove removes the entry of the subscribe, it reports whether there was
+// one.
+func (a *SubscribeAddrs) Remove(subscribeID uint) bool {
+	for i := range *a {
+		if (*a)[i].SubscribeID == subscribeID {
+			*a = append((*a)[:i], (*a)[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
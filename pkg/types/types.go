@@ -17,6 +17,7 @@ limitations under the License.
 package types
 
 import (
+	"encoding/json"
 	"os"
 	"strings"
 
@@ -37,13 +38,56 @@ func Interface2string(in interface{}) (out string) {
 	return
 }
 
+// message types of the WebSocket protocol.
+const (
+	WsSubscribe   = "subscribe"
+	WsUnsubscribe = "unsubscribe"
+	WsList        = "list"
+	WsAck         = "ack"
+	WsError       = "error"
+	WsData        = "data"
+	WsSnapshot    = "snapshot"
+)
+
+// WsRequest is a message of a WebSocket client. A request without Type is a
+// legacy request which replaces the watched entity with ID.
 type WsRequest struct {
 	Type string `json:"type,omitempty"`
-	ID   string `json:"id,omitempty"`
-	Mode string `json:"mode,omitempty"`
+	// RequestID is echoed in the reply to the request.
+	RequestID string   `json:"request_id,omitempty"`
+	IDs       []string `json:"ids,omitempty"`
+	// Fields are the dot separated paths of the properties a subscribe
+	// request selects, all properties if empty.
+	Fields []string `json:"fields,omitempty"`
+	ID     string   `json:"id,omitempty"`
+}
+
+// WsResponse is a message to a WebSocket client, the reply to a request or
+// the property update of an entity.
+type WsResponse struct {
+	Type string `json:"type"`
+	// Seq numbers the messages of a connection in the order they are sent.
+	Seq       uint64 `json:"seq"`
+	RequestID string `json:"request_id,omitempty"`
+	// IDs are the entities the request applied to, for WsList all entities
+	// of the connection.
+	IDs    []string    `json:"ids,omitempty"`
+	Failed []WsFailure `json:"failed,omitempty"`
+	Error  string      `json:"error,omitempty"`
+
+	EntityID   string          `json:"entity_id,omitempty"`
+	Properties json.RawMessage `json:"properties,omitempty"`
+}
+
+// WsFailure is an entity a request failed for.
+type WsFailure struct {
+	ID    string `json:"id"`
+	Error string `json:"error"`
 }
 
-const PubsubName = "core-broker-pubsub"
+// DeliveryTopic is the topic core publishes the property updates of
+// subscriptions processed by the broker (e.g. aggregation) to.
+const DeliveryTopic = "core-broker-delivery"
 
 func SubscriptionIDByJoin(entityID, topic string) string {
 	return entityID + "_" + topic
@@ -0,0 +1,49 @@
+package util
+
+import "sync"
+
+// KeyedMutex is a mutex per key, the mutex of a key is freed once nobody
+// holds or waits for it.
+type KeyedMutex struct {
+	lock  sync.Mutex
+	locks map[string]*keyedLock
+}
+
+type keyedLock struct {
+	sync.Mutex
+	refs int
+}
+
+func NewKeyedMutex() *KeyedMutex {
+	return &KeyedMutex{locks: make(map[string]*keyedLock)}
+}
+
+// Lock locks the mutex of the key and returns the function unlocking it.
+func (m *KeyedMutex) Lock(key string) (unlock func()) {
+	m.lock.Lock()
+	l, ok := m.locks[key]
+	if !ok {
+		l = &keyedLock{}
+		m.locks[key] = l
+	}
+	l.refs++
+	m.lock.Unlock()
+
+	l.Lock()
+	return func() {
+		l.Unlock()
+		m.lock.Lock()
+		l.refs--
+		if l.refs == 0 {
+			delete(m.locks, key)
+		}
+		m.lock.Unlock()
+	}
+}
+
+// Len is the number of keys locked or waited for.
+func (m *KeyedMutex) Len() int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return len(m.locks)
+}
@@ -1,6 +1,7 @@
 package util
 
 import (
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -23,3 +24,24 @@ func TestGenerateSubscribeEndpoint(t *testing.T) {
 	s := GenerateSubscribeEndpoint()
 	assert.NotEqual(t, make([]string, 16), s)
 }
+
+func TestKeyedMutex(t *testing.T) {
+	m := NewKeyedMutex()
+	counters := map[string]*int{"a": new(int), "b": new(int)}
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		for key, counter := range counters {
+			wg.Add(1)
+			go func(key string, counter *int) {
+				defer wg.Done()
+				unlock := m.Lock(key)
+				defer unlock()
+				*counter++
+			}(key, counter)
+		}
+	}
+	wg.Wait()
+	assert.Equal(t, 100, *counters["a"])
+	assert.Equal(t, 100, *counters["b"])
+	assert.Equal(t, 0, m.Len())
+}